@@ -0,0 +1,9 @@
+package constants
+
+type AvailabilityChange string
+
+const (
+	AVAILABILITY_CHANGE_CREATED AvailabilityChange = "created"
+	AVAILABILITY_CHANGE_UPDATED AvailabilityChange = "updated"
+	AVAILABILITY_CHANGE_DELETED AvailabilityChange = "deleted"
+)
@@ -0,0 +1,10 @@
+package constants
+
+// CommentChange tells what happened to the comment sent on the SSE connection of the event
+type CommentChange string
+
+const (
+	COMMENT_CHANGE_CREATED CommentChange = "created"
+	COMMENT_CHANGE_UPDATED CommentChange = "updated"
+	COMMENT_CHANGE_DELETED CommentChange = "deleted"
+)
@@ -0,0 +1,29 @@
+package constants
+
+// DomainEventType names the events published on the domain event bus when something happens to an event
+type DomainEventType string
+
+const (
+	DOMAIN_EVENT_STATUS_CHANGED       DomainEventType = "event.status-changed"
+	DOMAIN_EVENT_EVENT_UPDATED        DomainEventType = "event.updated"
+	DOMAIN_EVENT_MEMBER_JOINED        DomainEventType = "member.joined"
+	DOMAIN_EVENT_MEMBER_LEFT          DomainEventType = "member.left"
+	DOMAIN_EVENT_AVAILABILITY_CREATED DomainEventType = "availability.created"
+	DOMAIN_EVENT_AVAILABILITY_UPDATED DomainEventType = "availability.updated"
+	DOMAIN_EVENT_AVAILABILITY_DELETED DomainEventType = "availability.deleted"
+	DOMAIN_EVENT_SLOT_CONFIRMED       DomainEventType = "slot.confirmed"
+	DOMAIN_EVENT_SLOT_REMOVED         DomainEventType = "slot.removed"
+)
+
+// ActivityTypes are the domain events kept in the activity log of the events
+var ActivityTypes = []DomainEventType{
+	DOMAIN_EVENT_STATUS_CHANGED,
+	DOMAIN_EVENT_EVENT_UPDATED,
+	DOMAIN_EVENT_MEMBER_JOINED,
+	DOMAIN_EVENT_MEMBER_LEFT,
+	DOMAIN_EVENT_AVAILABILITY_CREATED,
+	DOMAIN_EVENT_AVAILABILITY_UPDATED,
+	DOMAIN_EVENT_AVAILABILITY_DELETED,
+	DOMAIN_EVENT_SLOT_CONFIRMED,
+	DOMAIN_EVENT_SLOT_REMOVED,
+}
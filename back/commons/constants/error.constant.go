@@ -30,6 +30,8 @@ var (
 	ERR_INVALID_IDENTIFIER_OR_PASSWORD = err("INVALID_IDENTIFIER_OR_PASSWORD", http.StatusUnauthorized)
 	ERR_TERMS_NOT_ACCEPTED             = err("TERMS_NOT_ACCEPTED", http.StatusForbidden)
 	ERR_USERNAME_MISSING               = err("USERNAME_MISSING", http.StatusForbidden)
+	ERR_ALREADY_AUTHENTICATED          = err("ALREADY_AUTHENTICATED", 0)
+	ERR_ADMIN_ACCESS_DENIED            = err("ADMIN_ACCESS_DENIED", http.StatusForbidden)
 	// Account
 	ERR_INVALID_EMAIL_FORMAT        = err("INVALID_EMAIL_FORMAT", 0)
 	ERR_INVALID_PASSWORD_FORMAT     = err("INVALID_PASSWORD_FORMAT", 0)
@@ -47,6 +49,35 @@ var (
 	ERR_EVENT_ALREADY_JOINED              = err("EVENT_ALREADY_JOINED", 0)
 	ERR_EVENT_ENDED                       = err("EVENT_ENDED", 0)
 	ERR_VALIDATED_SLOT_CANNOT_BE_MODIFIED = err("VALIDATED_SLOT_CANNOT_BE_MODIFIED", 0)
+	ERR_EVENT_PARTICIPANT_NOT_FOUND       = err("EVENT_PARTICIPANT_NOT_FOUND", http.StatusNotFound)
+	ERR_EVENT_OWNER_ROLE_LOCKED           = err("EVENT_OWNER_ROLE_LOCKED", 0)
+	ERR_EVENT_OWNER_CANNOT_LEAVE          = err("EVENT_OWNER_CANNOT_LEAVE", 0)
+	ERR_EVENT_CANCELLED                   = err("EVENT_CANCELLED", 0)
+	ERR_EVENT_INVALID_MEETING_URL         = err("EVENT_INVALID_MEETING_URL", 0)
+	ERR_EVENT_INVALID_DECISION_DEADLINE   = err("EVENT_INVALID_DECISION_DEADLINE", 0)
+	ERR_EVENT_INVALID_LIST_FILTER         = err("EVENT_INVALID_LIST_FILTER", 0)
+	ERR_EVENT_FULL                        = err("EVENT_FULL", 0)
+	ERR_EVENT_VERSION_CONFLICT            = err("EVENT_VERSION_CONFLICT", http.StatusConflict)
+	ERR_EVENT_VERSION_MISMATCH            = err("EVENT_VERSION_MISMATCH", http.StatusPreconditionFailed)
+	// Invitation
+	ERR_INVITATION_REQUIRED           = err("INVITATION_REQUIRED", http.StatusForbidden)
+	ERR_INVITATION_NOT_FOUND          = err("INVITATION_NOT_FOUND", http.StatusNotFound)
+	ERR_INVITATION_EXPIRED            = err("INVITATION_EXPIRED", 0)
+	ERR_INVITATION_REVOKED            = err("INVITATION_REVOKED", 0)
+	ERR_INVITATION_MAX_USES_REACHED   = err("INVITATION_MAX_USES_REACHED", 0)
+	ERR_INVITATION_INVALID_EXPIRATION = err("INVITATION_INVALID_EXPIRATION", 0)
+	// Join request
+	ERR_JOIN_REQUEST_PENDING         = err("JOIN_REQUEST_PENDING", http.StatusForbidden)
+	ERR_JOIN_REQUEST_REJECTED        = err("JOIN_REQUEST_REJECTED", http.StatusForbidden)
+	ERR_JOIN_REQUEST_NOT_FOUND       = err("JOIN_REQUEST_NOT_FOUND", http.StatusNotFound)
+	ERR_JOIN_REQUEST_ALREADY_DECIDED = err("JOIN_REQUEST_ALREADY_DECIDED", 0)
+	ERR_JOIN_REQUEST_WAITLISTED      = err("JOIN_REQUEST_WAITLISTED", http.StatusForbidden)
+	// Event template
+	ERR_EVENT_TEMPLATE_NOT_FOUND = err("EVENT_TEMPLATE_NOT_FOUND", http.StatusNotFound)
+	// Ownership
+	ERR_OWNERSHIP_NOMINEE_INVALID      = err("OWNERSHIP_NOMINEE_INVALID", 0)
+	ERR_OWNERSHIP_NOMINATION_NOT_FOUND = err("OWNERSHIP_NOMINATION_NOT_FOUND", http.StatusNotFound)
+	ERR_OWNERSHIP_OWNER_ACCOUNT_ACTIVE = err("OWNERSHIP_OWNER_ACCOUNT_ACTIVE", 0)
 	// Availability
 	ERR_AVAILABILITY_ACCESS_DENIED         = err("AVAILABILITY_ACCESS_DENIED", http.StatusForbidden)
 	ERR_AVAILABILITY_DURATION_TOO_SHORT    = err("AVAILABILITY_DURATION_TOO_SHORT", 0)
@@ -54,16 +85,27 @@ var (
 	ERR_AVAILABILITY_END_AFTER_EVENT       = err("AVAILABILITY_END_AFTER_EVENT", 0)
 	ERR_AVAILABILITY_INVALID_TIME_INTERVAL = err("AVAILABILITY_INVALID_TIME_INTERVAL", 0)
 	ERR_AVAILABILITY_NOT_FOUND             = err("AVAILABILITY_NOT_FOUND", http.StatusNotFound)
+	ERR_AVAILABILITY_COPY_SAME_EVENT       = err("AVAILABILITY_COPY_SAME_EVENT", 0)
+	ERR_AVAILABILITY_INVALID_EXPORT_FORMAT = err("AVAILABILITY_INVALID_EXPORT_FORMAT", 0)
+	ERR_AVAILABILITY_EXPORT_TOO_LARGE      = err("AVAILABILITY_EXPORT_TOO_LARGE", 0)
 	// Slot
 	ERR_SLOT_NOT_FOUND         = err("SLOT_NOT_FOUND", http.StatusNotFound)
 	ERR_SLOT_INVALID_STARTS_AT = err("SLOT_INVALID_STARTS_AT", 0)
 	ERR_SLOT_INVALID_ENDS_AT   = err("SLOT_INVALID_ENDS_AT", 0)
+	// RSVP
+	ERR_RSVP_TOKEN_INVALID = err("RSVP_TOKEN_INVALID", http.StatusBadRequest)
+	ERR_RSVP_CLOSED        = err("RSVP_CLOSED", 0)
+	// Comment
+	ERR_COMMENT_NOT_FOUND     = err("COMMENT_NOT_FOUND", http.StatusNotFound)
+	ERR_COMMENT_ACCESS_DENIED = err("COMMENT_ACCESS_DENIED", http.StatusForbidden)
+	ERR_COMMENT_EMPTY         = err("COMMENT_EMPTY", 0)
 	// Misc
 	ERR_INVALID_COLOR_FORMAT = err("INVALID_COLOR_FORMAT", 0)
 	// Pagination
 	ERR_INVALID_PAGINATION_PARAMS = err("INVALID_PAGINATION_PARAMS", 0)
 	ERR_INVALID_PAGINATION_PAGE   = err("INVALID_PAGINATION_PAGE", 0)
 	ERR_INVALID_PAGINATION_LIMIT  = err("INVALID_PAGINATION_LIMIT", 0)
+	ERR_INVALID_PAGINATION_CURSOR = err("INVALID_PAGINATION_CURSOR", 0)
 )
 
 var CUSTOM_ERRORS = []CustomError{
@@ -76,6 +118,8 @@ var CUSTOM_ERRORS = []CustomError{
 	ERR_INVALID_IDENTIFIER_OR_PASSWORD,
 	ERR_TERMS_NOT_ACCEPTED,
 	ERR_USERNAME_MISSING,
+	ERR_ALREADY_AUTHENTICATED,
+	ERR_ADMIN_ACCESS_DENIED,
 	// Account
 	ERR_INVALID_EMAIL_FORMAT,
 	ERR_INVALID_PASSWORD_FORMAT,
@@ -93,6 +137,35 @@ var CUSTOM_ERRORS = []CustomError{
 	ERR_EVENT_ALREADY_JOINED,
 	ERR_EVENT_ENDED,
 	ERR_VALIDATED_SLOT_CANNOT_BE_MODIFIED,
+	ERR_EVENT_PARTICIPANT_NOT_FOUND,
+	ERR_EVENT_OWNER_ROLE_LOCKED,
+	ERR_EVENT_OWNER_CANNOT_LEAVE,
+	ERR_EVENT_CANCELLED,
+	ERR_EVENT_INVALID_MEETING_URL,
+	ERR_EVENT_INVALID_DECISION_DEADLINE,
+	ERR_EVENT_INVALID_LIST_FILTER,
+	ERR_EVENT_FULL,
+	ERR_EVENT_VERSION_CONFLICT,
+	ERR_EVENT_VERSION_MISMATCH,
+	// Invitation
+	ERR_INVITATION_REQUIRED,
+	ERR_INVITATION_NOT_FOUND,
+	ERR_INVITATION_EXPIRED,
+	ERR_INVITATION_REVOKED,
+	ERR_INVITATION_MAX_USES_REACHED,
+	ERR_INVITATION_INVALID_EXPIRATION,
+	// Join request
+	ERR_JOIN_REQUEST_PENDING,
+	ERR_JOIN_REQUEST_REJECTED,
+	ERR_JOIN_REQUEST_NOT_FOUND,
+	ERR_JOIN_REQUEST_ALREADY_DECIDED,
+	ERR_JOIN_REQUEST_WAITLISTED,
+	// Event template
+	ERR_EVENT_TEMPLATE_NOT_FOUND,
+	// Ownership
+	ERR_OWNERSHIP_NOMINEE_INVALID,
+	ERR_OWNERSHIP_NOMINATION_NOT_FOUND,
+	ERR_OWNERSHIP_OWNER_ACCOUNT_ACTIVE,
 	// Availability
 	ERR_AVAILABILITY_ACCESS_DENIED,
 	ERR_AVAILABILITY_DURATION_TOO_SHORT,
@@ -100,16 +173,26 @@ var CUSTOM_ERRORS = []CustomError{
 	ERR_AVAILABILITY_END_AFTER_EVENT,
 	ERR_AVAILABILITY_INVALID_TIME_INTERVAL,
 	ERR_AVAILABILITY_NOT_FOUND,
+	ERR_AVAILABILITY_COPY_SAME_EVENT,
+	ERR_AVAILABILITY_INVALID_EXPORT_FORMAT,
+	ERR_AVAILABILITY_EXPORT_TOO_LARGE,
 	// Slot
 	ERR_SLOT_NOT_FOUND,
 	ERR_SLOT_INVALID_STARTS_AT,
 	ERR_SLOT_INVALID_ENDS_AT,
+	ERR_RSVP_TOKEN_INVALID,
+	ERR_RSVP_CLOSED,
+	// Comment
+	ERR_COMMENT_NOT_FOUND,
+	ERR_COMMENT_ACCESS_DENIED,
+	ERR_COMMENT_EMPTY,
 	// Misc
 	ERR_INVALID_COLOR_FORMAT,
 	// Pagination
 	ERR_INVALID_PAGINATION_PARAMS,
 	ERR_INVALID_PAGINATION_PAGE,
 	ERR_INVALID_PAGINATION_LIMIT,
+	ERR_INVALID_PAGINATION_CURSOR,
 }
 
 var CUSTOM_ERRORS_MAP = func() map[string]CustomError {
@@ -0,0 +1,33 @@
+package constants
+
+import "slices"
+
+type EventRole string
+
+const (
+	EVENT_ROLE_OWNER       EventRole = "OWNER"
+	EVENT_ROLE_ORGANISER   EventRole = "ORGANISER"
+	EVENT_ROLE_PARTICIPANT EventRole = "PARTICIPANT"
+	EVENT_ROLE_VIEWER      EventRole = "VIEWER"
+)
+
+type EventPermission string
+
+const (
+	EVENT_PERMISSION_VIEW       EventPermission = "VIEW"       // See the event, its availabilities and slots
+	EVENT_PERMISSION_CONTRIBUTE EventPermission = "CONTRIBUTE" // Submit own availabilities
+	EVENT_PERMISSION_MANAGE     EventPermission = "MANAGE"     // Edit the event, confirm slots, act on behalf of participants, handle invitations and join requests
+	EVENT_PERMISSION_ADMINISTER EventPermission = "ADMINISTER" // Change the roles of the members
+)
+
+var eventRolePermissions = map[EventRole][]EventPermission{
+	EVENT_ROLE_OWNER:       {EVENT_PERMISSION_VIEW, EVENT_PERMISSION_CONTRIBUTE, EVENT_PERMISSION_MANAGE, EVENT_PERMISSION_ADMINISTER},
+	EVENT_ROLE_ORGANISER:   {EVENT_PERMISSION_VIEW, EVENT_PERMISSION_CONTRIBUTE, EVENT_PERMISSION_MANAGE},
+	EVENT_ROLE_PARTICIPANT: {EVENT_PERMISSION_VIEW, EVENT_PERMISSION_CONTRIBUTE},
+	EVENT_ROLE_VIEWER:      {EVENT_PERMISSION_VIEW},
+}
+
+// Grants checks if the role includes the permission
+func (r EventRole) Grants(permission EventPermission) bool {
+	return slices.Contains(eventRolePermissions[r], permission)
+}
@@ -0,0 +1,11 @@
+package constants
+
+type JoinRequestStatus string
+
+const (
+	JOIN_REQUEST_STATUS_PENDING  JoinRequestStatus = "PENDING"
+	JOIN_REQUEST_STATUS_APPROVED JoinRequestStatus = "APPROVED"
+	JOIN_REQUEST_STATUS_REJECTED JoinRequestStatus = "REJECTED"
+	// The event was full, the request becomes approved when a seat opens up
+	JOIN_REQUEST_STATUS_WAITLISTED JoinRequestStatus = "WAITLISTED"
+)
@@ -8,6 +8,19 @@ const (
 	MAIL_TEMPLATE_PASSWORD_RESET_CONFIRMATION MailTemplate = "password-reset-confirmation"
 	MAIL_TEMPLATE_EVENT_CONFIRMATION          MailTemplate = "event-confirmation"
 	MAIL_TEMPLATE_EVENT_CANCELLATION          MailTemplate = "event-cancellation"
+	MAIL_TEMPLATE_AVAILABILITY_PROXY          MailTemplate = "availability-proxy"
+	MAIL_TEMPLATE_JOIN_REQUEST                MailTemplate = "join-request"
+	MAIL_TEMPLATE_OWNERSHIP_NOMINATION        MailTemplate = "ownership-nomination"
+	MAIL_TEMPLATE_OWNERSHIP_TRANSFERRED       MailTemplate = "ownership-transferred"
+	MAIL_TEMPLATE_PARTICIPANT_LEFT            MailTemplate = "participant-left"
+	MAIL_TEMPLATE_EVENT_CANCELLED             MailTemplate = "event-cancelled"
+	MAIL_TEMPLATE_DEADLINE_REMINDER           MailTemplate = "deadline-reminder"
+	MAIL_TEMPLATE_DEADLINE_MISSED             MailTemplate = "deadline-missed"
+	MAIL_TEMPLATE_EVENT_INVITATION            MailTemplate = "event-invitation"
+	MAIL_TEMPLATE_WAITLIST_PROMOTED           MailTemplate = "waitlist-promoted"
+	MAIL_TEMPLATE_EVENT_RESCHEDULING          MailTemplate = "event-rescheduling"
+	MAIL_TEMPLATE_COMMENT_MENTION             MailTemplate = "comment-mention"
+	MAIL_TEMPLATE_COMMENT_DIGEST              MailTemplate = "comment-digest"
 )
 
 const (
@@ -21,4 +34,32 @@ const (
 	MAIL_SUBJECT_EVENT_CONFIRMATION_FR     = "Évènement confirmé"
 	MAIL_SUBJECT_EVENT_CANCELLATION_EN     = "Event cancelled"
 	MAIL_SUBJECT_EVENT_CANCELLATION_FR     = "Évènement annulé"
+	MAIL_SUBJECT_AVAILABILITY_PROXY_EN     = "Your availability was updated"
+	MAIL_SUBJECT_AVAILABILITY_PROXY_FR     = "Votre disponibilité a été modifiée"
+	MAIL_SUBJECT_JOIN_REQUEST_EN           = "New request to join your event"
+	MAIL_SUBJECT_JOIN_REQUEST_FR           = "Nouvelle demande pour rejoindre votre évènement"
+	MAIL_SUBJECT_OWNERSHIP_NOMINATION_EN   = "You have been nominated as event owner"
+	MAIL_SUBJECT_OWNERSHIP_NOMINATION_FR   = "Vous avez été proposé comme propriétaire d'un évènement"
+	MAIL_SUBJECT_OWNERSHIP_TRANSFERRED_EN  = "Event ownership transferred"
+	MAIL_SUBJECT_OWNERSHIP_TRANSFERRED_FR  = "Propriété de l'évènement transférée"
+	MAIL_SUBJECT_PARTICIPANT_LEFT_EN       = "A participant left your event"
+	MAIL_SUBJECT_PARTICIPANT_LEFT_FR       = "Un participant a quitté votre évènement"
+	MAIL_SUBJECT_EVENT_CANCELLED_EN        = "This event has been cancelled"
+	MAIL_SUBJECT_EVENT_CANCELLED_FR        = "Cet évènement a été annulé"
+	MAIL_SUBJECT_DEADLINE_REMINDER_EN      = "Your availability is expected"
+	MAIL_SUBJECT_DEADLINE_REMINDER_FR      = "Vos disponibilités sont attendues"
+	MAIL_SUBJECT_DEADLINE_MISSED_EN        = "No slot found before the deadline"
+	MAIL_SUBJECT_DEADLINE_MISSED_FR        = "Aucun créneau trouvé avant la date limite"
+	MAIL_SUBJECT_EVENT_INVITATION_EN       = "You have been added to an event"
+	MAIL_SUBJECT_EVENT_INVITATION_FR       = "Vous avez été ajouté à un évènement"
+	MAIL_SUBJECT_WAITLIST_PROMOTED_EN      = "A seat opened up for you"
+	MAIL_SUBJECT_WAITLIST_PROMOTED_FR      = "Une place s'est libérée pour vous"
+	MAIL_SUBJECT_EVENT_RESCHEDULING_EN     = "Your event is being rescheduled"
+	MAIL_SUBJECT_EVENT_RESCHEDULING_FR     = "Votre évènement est en cours de replanification"
+	MAIL_SUBJECT_EVENT_RESCHEDULED_EN      = "Event rescheduled"
+	MAIL_SUBJECT_EVENT_RESCHEDULED_FR      = "Évènement replanifié"
+	MAIL_SUBJECT_COMMENT_MENTION_EN        = "You were mentioned in a comment"
+	MAIL_SUBJECT_COMMENT_MENTION_FR        = "Vous avez été mentionné dans un commentaire"
+	MAIL_SUBJECT_COMMENT_DIGEST_EN         = "New comments on your event"
+	MAIL_SUBJECT_COMMENT_DIGEST_FR         = "Nouveaux commentaires sur votre évènement"
 )
@@ -0,0 +1,10 @@
+package constants
+
+// RsvpStatus is the answer of a member about attending the validated slot of an event
+type RsvpStatus string
+
+const (
+	RSVP_STATUS_ATTENDING RsvpStatus = "ATTENDING"
+	RSVP_STATUS_DECLINED  RsvpStatus = "DECLINED"
+	RSVP_STATUS_TENTATIVE RsvpStatus = "TENTATIVE"
+)
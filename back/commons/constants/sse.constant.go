@@ -0,0 +1,15 @@
+package constants
+
+// SSEEvent names the frames sent on the event stream. Slot updates are sent unnamed.
+type SSEEvent string
+
+const (
+	SSE_EVENT_JOIN_REQUEST        SSEEvent = "join-request"
+	SSE_EVENT_PARTICIPANT_LEFT    SSEEvent = "participant-left"
+	SSE_EVENT_EVENT_CANCELLED     SSEEvent = "event-cancelled"
+	SSE_EVENT_SLOT_AUTO_CONFIRMED SSEEvent = "slot-auto-confirmed"
+	SSE_EVENT_RSVP_COUNTS         SSEEvent = "rsvp-counts"
+	SSE_EVENT_ACTIVITY            SSEEvent = "activity"
+	SSE_EVENT_COMMENT             SSEEvent = "comment"
+	SSE_EVENT_COMMENT_MENTION     SSEEvent = "comment-mention"
+)
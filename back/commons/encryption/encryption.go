@@ -3,7 +3,9 @@ package encryption
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"errors"
 	"io"
@@ -69,3 +71,26 @@ func Decrypt(text string) (string, error) {
 
 	return string(plainText), nil
 }
+
+// Sign returns the HMAC-SHA256 signature of the text, encoded for URLs
+func Sign(text string) (string, error) {
+	key, err := getKey()
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(text))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Verify checks in constant time that the signature was made by Sign for the text
+func Verify(text string, signature string) bool {
+	expected, err := Sign(text)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -30,3 +30,13 @@ func TestDecryptWithInvalidBase64(t *testing.T) {
 		assert.Contains(t, err.Error(), "illegal base64", "Error message should mention base64 decoding")
 	}
 }
+
+func TestSignVerify(t *testing.T) {
+	_ = os.Setenv("ENCRYPTION_KEY", testKey)
+
+	signature, err := Sign("page-2")
+	assert.NoError(t, err)
+	assert.True(t, Verify("page-2", signature))
+	assert.False(t, Verify("page-3", signature), "Signature must not match another text")
+	assert.False(t, Verify("page-2", signature+"x"), "Altered signature must be rejected")
+}
@@ -0,0 +1,27 @@
+package guard
+
+import (
+	"app/commons/constants"
+	"app/commons/helpers"
+	"app/config"
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+)
+
+const adminApiKeyHeader = "X-Admin-Key"
+
+// AdminCheck only lets through requests carrying the configured admin API key.
+// Admin routes are disabled when no key is configured.
+func AdminCheck() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adminApiKey := config.GetConfig().Auth.AdminApiKey
+		providedKey := c.GetHeader(adminApiKeyHeader)
+		if adminApiKey == "" || subtle.ConstantTimeCompare([]byte(providedKey), []byte(adminApiKey)) != 1 {
+			helpers.HandleJSONResponse(c, nil, constants.ERR_ADMIN_ACCESS_DENIED.Err)
+			return
+		}
+
+		c.Next()
+	}
+}
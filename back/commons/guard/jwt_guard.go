@@ -21,6 +21,7 @@ type Claims struct {
 	Username      *string   `json:"username"`
 	Email         *string   `json:"email"`
 	TermsAccepted bool      `json:"termsAccepted"`
+	Guest         bool      `json:"guest,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -61,6 +62,33 @@ func ParseToken(jwtToken string) (*Claims, error) {
 
 // GenerateAccessToken generates a new access token for the given claims
 func GenerateAccessToken(claims *Claims) (string, error) {
+	return signToken(claims, constants.ACCESS_TOKEN_EXPIRATION)
+}
+
+// GenerateGuestToken generates a long-lived token identifying a guest participant
+func GenerateGuestToken(claims *Claims) (string, error) {
+	claims.Guest = true
+	claims.TermsAccepted = false
+
+	return signToken(claims, constants.GUEST_TOKEN_EXPIRATION)
+}
+
+// GetGuestClaims returns the claims of the guest token cookie, nil if missing or invalid
+func GetGuestClaims(c *gin.Context) *Claims {
+	guestToken, err := c.Cookie("guest_token")
+	if err != nil || guestToken == "" {
+		return nil
+	}
+
+	claims, err := ParseToken(guestToken)
+	if err != nil || !claims.Guest {
+		return nil
+	}
+
+	return claims
+}
+
+func signToken(claims *Claims, expiration time.Duration) (string, error) {
 	config := config.GetConfig()
 
 	privateKeyFile, err := os.ReadFile(config.Auth.PrivatePemPath)
@@ -73,7 +101,7 @@ func GenerateAccessToken(claims *Claims) (string, error) {
 		return "", err
 	}
 
-	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(constants.ACCESS_TOKEN_EXPIRATION))
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(expiration))
 
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
 
@@ -98,17 +126,26 @@ func ShouldRenewToken(claims *Claims) bool {
 type AuthCheckParams struct {
 	RequireAuthentication  bool
 	RequireCompleteProfile bool
+	// Accept the guest token cookie when no access token is present
+	AllowGuest bool
 }
 
 // Set params to nil to enable all checks
 func AuthCheck(params *AuthCheckParams) gin.HandlerFunc {
 	if params == nil {
-		params = &AuthCheckParams{true, true}
+		params = &AuthCheckParams{true, true, false}
 	}
 
 	return func(c *gin.Context) {
 		jwt, err := c.Cookie("access_token")
 		if err != nil {
+			if params.AllowGuest {
+				if guest := GetGuestClaims(c); guest != nil {
+					c.Set("user", guest)
+					c.Next()
+					return
+				}
+			}
 			if !params.RequireAuthentication { // validate auth
 				c.Next()
 				return
@@ -127,6 +164,12 @@ func AuthCheck(params *AuthCheckParams) gin.HandlerFunc {
 			return
 		}
 
+		// Guest tokens are only accepted from the guest cookie
+		if claims.Guest {
+			helpers.HandleJSONResponse(c, nil, constants.ERR_TOKEN_INVALID.Err)
+			return
+		}
+
 		if params.RequireCompleteProfile {
 			if claims.Username == nil {
 				helpers.HandleJSONResponse(c, nil, constants.ERR_USERNAME_MISSING.Err)
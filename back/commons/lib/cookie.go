@@ -38,3 +38,19 @@ func SetRefreshTokenCookie(c *gin.Context, token string, expiration int) {
 		true,                   // httpOnly
 	)
 }
+
+func SetGuestTokenCookie(c *gin.Context, token string, expiration int) {
+	if expiration == 0 {
+		expiration = int(constants.GUEST_TOKEN_EXPIRATION / time.Second)
+	}
+
+	c.SetCookie(
+		"guest_token", // name
+		token,         // value
+		expiration,    // max age in seconds
+		"/api",        // path
+		"",            // domain (empty = current domain)
+		true,          // secure
+		true,          // httpOnly
+	)
+}
@@ -21,6 +21,23 @@ func FormatLocalizedDate(start, end time.Time, lang constants.AccountLanguage) s
 	return formatMultiDay(start, endInLoc, lang)
 }
 
+// FormatLocalizedDateTime formats a single date time into a localized human-friendly string
+func FormatLocalizedDateTime(t time.Time, lang constants.AccountLanguage) string {
+	switch lang {
+	case constants.ACCOUNT_LANGUAGE_FR:
+		// "Lundi 06 décembre à 20h00"
+		return fmt.Sprintf("%s %s à %s", formatWeekday(t, lang), formatDayMonth(t, lang), formatTime(t, lang))
+	default:
+		// "Thursday, May 14, 17:00"
+		return fmt.Sprintf(
+			"%s, %s, %s",
+			formatWeekday(t, constants.ACCOUNT_LANGUAGE_EN),
+			formatEnglishMonthDay(t),
+			formatTime(t, constants.ACCOUNT_LANGUAGE_EN),
+		)
+	}
+}
+
 func formatSameDay(start, end time.Time, lang constants.AccountLanguage) string {
 	switch lang {
 	case constants.ACCOUNT_LANGUAGE_FR:
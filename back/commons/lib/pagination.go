@@ -2,17 +2,27 @@ package lib
 
 import (
 	"app/commons/constants"
+	"app/commons/encryption"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
 
 type Pagination[T any] struct {
-	Data   []T   `json:"data"`
-	Page   int   `form:"page,default=1" json:"page" binding:"min=1,max=100"`
-	Limit  int   `form:"limit,default=20" json:"limit" binding:"min=1,max=50"`
-	Offset int   `form:"-" json:"-"`
-	Total  int64 `json:"total"`
+	Data   []T `json:"data"`
+	Page   int `form:"page,default=1" json:"page" binding:"min=1,max=100"`
+	Limit  int `form:"limit,default=20" json:"limit" binding:"min=1,max=50"`
+	Offset int `form:"-" json:"-"`
+	// Not counted in cursor mode
+	Total *int64 `json:"total,omitempty"`
+	// Position after which the page starts in cursor mode, empty for the first page
+	Cursor string `form:"cursor" json:"-"`
+	// Cursor of the next page, nil on the last page
+	NextCursor *string `form:"-" json:"nextCursor,omitempty"`
+	cursorMode bool
 }
 
 func (p *Pagination[T]) ParseQuery(c *gin.Context) error {
@@ -27,3 +37,119 @@ func (p *Pagination[T]) ParseQuery(c *gin.Context) error {
 
 	return nil
 }
+
+// ParseCursorQuery is used by the endpoints supporting the cursor mode in place of ParseQuery.
+// The cursor mode is chosen when the cursor parameter is given, even empty, otherwise the page and limit are used.
+func (p *Pagination[T]) ParseCursorQuery(c *gin.Context) error {
+	if err := p.ParseQuery(c); err != nil {
+		return err
+	}
+	_, p.cursorMode = c.GetQuery("cursor")
+
+	return nil
+}
+
+func (p *Pagination[T]) IsCursorMode() bool {
+	return p.cursorMode
+}
+
+// DecodeCursor reads the position given by the client into position.
+// Returns false without error on the first page.
+func (p *Pagination[T]) DecodeCursor(position any) (bool, error) {
+	if p.Cursor == "" {
+		return false, nil
+	}
+
+	if err := DecodeCursor(p.Cursor, position); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SetNextCursor gives the position of the last row of the page to the client
+func (p *Pagination[T]) SetNextCursor(position any) error {
+	cursor, err := EncodeCursor(position)
+	if err != nil {
+		return err
+	}
+	p.NextCursor = &cursor
+
+	return nil
+}
+
+// LoadPage loads the rows of the page with fetch, from the offset or after the position given by the client.
+// In cursor mode the rows are not counted: one more row is fetched to tell whether there is a next page,
+// whose cursor is then set to positionOf the last row of the page.
+func LoadPage[T any, R any, C any](
+	p *Pagination[T],
+	count func() (int64, error),
+	fetch func(after *C, limit int, offset int) ([]R, error),
+	positionOf func(row R) C,
+) ([]R, error) {
+	limit := p.Limit
+	var after *C
+	if p.IsCursorMode() {
+		var position C
+		if hasCursor, err := p.DecodeCursor(&position); err != nil {
+			return nil, err
+		} else if hasCursor {
+			after = &position
+		}
+		limit++
+	} else {
+		total, err := count()
+		if err != nil {
+			return nil, err
+		}
+		p.Total = &total
+	}
+
+	rows, err := fetch(after, limit, p.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.IsCursorMode() && len(rows) > p.Limit {
+		rows = rows[:p.Limit]
+		if err := p.SetNextCursor(positionOf(rows[len(rows)-1])); err != nil {
+			return nil, err
+		}
+	}
+
+	return rows, nil
+}
+
+// EncodeCursor serializes the position and signs it, so that clients cannot forge positions
+func EncodeCursor(position any) (string, error) {
+	data, err := json.Marshal(position)
+	if err != nil {
+		return "", err
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	signature, err := encryption.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return payload + "." + signature, nil
+}
+
+// DecodeCursor checks the signature of a cursor made by EncodeCursor and reads the position
+func DecodeCursor(cursor string, position any) error {
+	payload, signature, found := strings.Cut(cursor, ".")
+	if !found || !encryption.Verify(payload, signature) {
+		return constants.ERR_INVALID_PAGINATION_CURSOR.Err
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return constants.ERR_INVALID_PAGINATION_CURSOR.Err
+	}
+	if err := json.Unmarshal(data, position); err != nil {
+		return constants.ERR_INVALID_PAGINATION_CURSOR.Err
+	}
+
+	return nil
+}
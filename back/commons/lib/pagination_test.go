@@ -0,0 +1,83 @@
+package lib
+
+import (
+	"app/commons/constants"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	_ = os.Setenv("ENCRYPTION_KEY", "1234567890abcdef")
+
+	type position struct {
+		Name string `json:"n"`
+		Id   int    `json:"i"`
+	}
+
+	cursor, err := EncodeCursor(position{Name: "Sprint review", Id: 42})
+	assert.NoError(t, err)
+
+	var decoded position
+	assert.NoError(t, DecodeCursor(cursor, &decoded))
+	assert.Equal(t, position{Name: "Sprint review", Id: 42}, decoded)
+
+	forged, err := EncodeCursor(position{Name: "Sprint review", Id: 43})
+	assert.NoError(t, err)
+	payload, _, _ := strings.Cut(forged, ".")
+	_, signature, _ := strings.Cut(cursor, ".")
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not a cursor", "page-2"},
+		{"forged payload", payload + "." + signature},
+		{"missing signature", payload},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, constants.ERR_INVALID_PAGINATION_CURSOR.Err, DecodeCursor(tt.cursor, &decoded))
+		})
+	}
+}
+
+func TestLoadPage(t *testing.T) {
+	_ = os.Setenv("ENCRYPTION_KEY", "1234567890abcdef")
+
+	rows := []int{1, 2, 3, 4, 5}
+	count := func() (int64, error) { return int64(len(rows)), nil }
+	fetch := func(after *int, limit int, offset int) ([]int, error) {
+		start := offset
+		if after != nil {
+			start = *after
+		}
+		return rows[start:min(start+limit, len(rows))], nil
+	}
+	positionOf := func(row int) int { return row }
+
+	pagination := Pagination[int]{Limit: 2, Offset: 2}
+	page, err := LoadPage(&pagination, count, fetch, positionOf)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, page)
+	if assert.NotNil(t, pagination.Total) {
+		assert.Equal(t, int64(5), *pagination.Total)
+	}
+	assert.Nil(t, pagination.NextCursor, "the next page is only given in cursor mode")
+
+	var paged []int
+	pagination = Pagination[int]{Limit: 2, cursorMode: true}
+	for {
+		page, err := LoadPage(&pagination, count, fetch, positionOf)
+		assert.NoError(t, err)
+		assert.Nil(t, pagination.Total, "the rows are not counted in cursor mode")
+		paged = append(paged, page...)
+		if pagination.NextCursor == nil {
+			break
+		}
+		pagination.Cursor, pagination.NextCursor = *pagination.NextCursor, nil
+	}
+	assert.Equal(t, rows, paged)
+}
@@ -32,3 +32,12 @@ func Capitalize(s string) string {
 	r[0] = []rune(strings.ToUpper(string(r[0])))[0]
 	return string(r)
 }
+
+// CopyString returns a pointer to a copy of the string, or nil
+func CopyString(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	copied := *value
+	return &copied
+}
@@ -0,0 +1,13 @@
+package lib
+
+import "net/url"
+
+// IsValidHttpUrl checks that the value is an absolute http or https URL
+func IsValidHttpUrl(value string) bool {
+	parsed, err := url.ParseRequestURI(value)
+	if err != nil {
+		return false
+	}
+
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
@@ -0,0 +1,52 @@
+package lib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidHttpUrl(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{
+			name:     "Valid https URL",
+			value:    "https://meet.example.com/team-sync",
+			expected: true,
+		},
+		{
+			name:     "Valid http URL with port",
+			value:    "http://localhost:8443/room",
+			expected: true,
+		},
+		{
+			name:     "Other scheme",
+			value:    "ftp://files.example.com",
+			expected: false,
+		},
+		{
+			name:     "Missing scheme",
+			value:    "meet.example.com/room",
+			expected: false,
+		},
+		{
+			name:     "Missing host",
+			value:    "https:///room",
+			expected: false,
+		},
+		{
+			name:     "Empty value",
+			value:    "",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsValidHttpUrl(tc.value))
+		})
+	}
+}
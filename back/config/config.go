@@ -14,6 +14,7 @@ type Config struct {
 	Auth     AuthConfiguration
 	Provider ProviderConfiguration
 	Email    EmailConfiguration
+	Meeting  MeetingConfiguration
 }
 
 var config *Config
@@ -29,6 +30,7 @@ func Init() *Config {
 		Auth:     GetAuthConfig(),
 		Provider: GetProviderConfig(),
 		Email:    GetEmailConfig(),
+		Meeting:  GetMeetingConfig(),
 	}
 
 	return config
@@ -7,6 +7,8 @@ import (
 type AuthConfiguration struct {
 	PublicPemPath  string `env:"AUTH_PUBLIC_PEM_PATH"`
 	PrivatePemPath string `env:"AUTH_PRIVATE_PEM_PATH"`
+	// Key expected by the admin routes, they are disabled when empty
+	AdminApiKey string `env:"ADMIN_API_KEY"`
 }
 
 func GetAuthConfig() AuthConfiguration {
@@ -14,5 +16,6 @@ func GetAuthConfig() AuthConfiguration {
 	return AuthConfiguration{
 		PublicPemPath:  os.Getenv("AUTH_PUBLIC_PEM_PATH"),
 		PrivatePemPath: os.Getenv("AUTH_PRIVATE_PEM_PATH"),
+		AdminApiKey:    os.Getenv("ADMIN_API_KEY"),
 	}
 }
@@ -0,0 +1,15 @@
+package config
+
+import (
+	"os"
+)
+
+type MeetingConfiguration struct {
+	JitsiBaseUrl string `env:"MEETING_JITSI_BASE_URL"`
+}
+
+func GetMeetingConfig() MeetingConfiguration {
+	return MeetingConfiguration{
+		JitsiBaseUrl: os.Getenv("MEETING_JITSI_BASE_URL"),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
 )
 
 func startMigration() (err error) {
@@ -25,6 +26,12 @@ func startMigration() (err error) {
 		&model.AccountEvent{},
 		&model.AccountProvider{},
 		&model.RefreshToken{},
+		&model.EventInvitation{},
+		&model.JoinRequest{},
+		&model.EventTemplate{},
+		&model.Rsvp{},
+		&model.ActivityLog{},
+		&model.Comment{},
 	}
 
 	for _, m := range models {
@@ -32,9 +39,67 @@ func startMigration() (err error) {
 			return err
 		}
 	}
+
+	// Migrate data
+	if err := runDataMigrationOnce("backfill_owner_roles", backfillOwnerRoles); err != nil {
+		return err
+	}
+
+	// Migrate indexes
+	if err := ensureEventSearchIndex(); err != nil {
+		return err
+	}
 	return nil
 }
 
+// runDataMigrationOnce applies a one-time data fix and records it in the data_migration table,
+// so that the next startups skip it
+func runDataMigrationOnce(name string, migrate func(tx *gorm.DB) error) error {
+	if err := conn.Exec(`
+	CREATE TABLE IF NOT EXISTS data_migration (
+		name text PRIMARY KEY,
+		applied_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)
+	`).Error; err != nil {
+		return err
+	}
+
+	return conn.Transaction(func(tx *gorm.DB) error {
+		// Nothing is inserted when the migration was already applied, e.g. by another instance starting at the same time
+		result := tx.Exec(`INSERT INTO data_migration (name) VALUES (?) ON CONFLICT (name) DO NOTHING`, name)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		log.Info().Str("name", name).Msg("Applying data migration")
+		return migrate(tx)
+	})
+}
+
+// backfillOwnerRoles gives the OWNER role to the membership of each event owner created before roles existed
+func backfillOwnerRoles(tx *gorm.DB) error {
+	return tx.Exec(`
+	UPDATE account_event
+	SET role = ?
+	FROM event
+	WHERE event.id = account_event.event_id
+	  AND event.owner_id = account_event.account_id
+	  AND account_event.role <> ?
+	`, constants.EVENT_ROLE_OWNER, constants.EVENT_ROLE_OWNER).Error
+}
+
+// ensureEventSearchIndex creates the text index used by the search of the event list,
+// the expression must match the one of EventRepository.filteredEventsQuery
+func ensureEventSearchIndex() error {
+	return conn.Exec(`
+	CREATE INDEX IF NOT EXISTS event_search_idx ON event
+	USING GIN (to_tsvector('simple', coalesce(event.name, '') || ' ' || coalesce(event.description, '')))
+	`).Error
+}
+
 func ensureEventStatusEnumType() error {
 	quoted := make([]string, 0, len(constants.EventStatuses))
 	for _, s := range constants.EventStatuses {
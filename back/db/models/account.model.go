@@ -27,6 +27,7 @@ type Account struct {
 	UpdatedAt            time.Time                 `gorm:"column:updated_at;default:CURRENT_TIMESTAMP" json:"-"`
 	DeletedAt            *time.Time                `gorm:"column:deleted_at;default:null" json:"-"`
 	TimeZone             string                    `gorm:"column:time_zone;type:varchar(50);default:'UTC'" json:"timeZone,omitempty"`
+	IsGuest              bool                      `gorm:"column:is_guest;default:false" json:"isGuest"`
 }
 
 func (Account) TableName() string {
@@ -52,5 +53,6 @@ func (a *Account) Sanitized(overrideColor *string) Account {
 		UserName:  a.UserName,
 		AvatarUrl: a.AvatarUrl,
 		Color:     color,
+		IsGuest:   a.IsGuest,
 	}
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"app/commons/constants"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,6 +12,13 @@ type AccountEvent struct {
 	EventId   uuid.UUID `gorm:"column:event_id;type:uuid;primaryKey" json:"-"`
 	Color     *string   `gorm:"column:color;size:7;default:null" json:"-"`
 	CreatedAt time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP" json:"createdAt"`
+	// Invitation used to join the event, nil for the owner and public joins
+	InvitationId *uuid.UUID `gorm:"column:invitation_id;type:uuid;default:null" json:"-"`
+	// Role of the member, it decides what the member can do in the event
+	Role constants.EventRole `gorm:"column:role;type:VARCHAR(20);default:'PARTICIPANT'" json:"role"`
+	// Whether the member receives the new comments of the event by email, and when the last digest was sent
+	CommentDigest       bool       `gorm:"column:comment_digest;not null;default:false" json:"-"`
+	CommentDigestSentAt *time.Time `gorm:"column:comment_digest_sent_at;default:null" json:"-"`
 	// Relations
 	Account Account `gorm:"foreignKey:AccountId;references:Id" json:"account"`
 	Event   Event   `gorm:"foreignKey:EventId;references:Id" json:"event"`
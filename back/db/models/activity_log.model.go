@@ -0,0 +1,28 @@
+package model
+
+import (
+	"app/commons/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActivityLog is an entry of the history of an event. Entries are only ever appended, never updated.
+type ActivityLog struct {
+	Id        uuid.UUID                 `gorm:"column:id;type:uuid;unique;primary_key" json:"id"`
+	EventId   uuid.UUID                 `gorm:"column:event_id;type:uuid;not null;index:idx_activity_log_event_created,priority:1" json:"-"`
+	ActorId   *uuid.UUID                `gorm:"column:actor_id;type:uuid;default:null" json:"-"`   // Nil when the change was made by the app itself
+	AccountId *uuid.UUID                `gorm:"column:account_id;type:uuid;default:null" json:"-"` // Member concerned by the change, nil for the event itself
+	Type      constants.DomainEventType `gorm:"column:type;size:50;not null" json:"type"`
+	// Values of the changed fields, Before is nil for a creation and After is nil for a deletion
+	Before    map[string]any `gorm:"column:before;type:jsonb;serializer:json" json:"before"`
+	After     map[string]any `gorm:"column:after;type:jsonb;serializer:json" json:"after"`
+	CreatedAt time.Time      `gorm:"column:created_at;index:idx_activity_log_event_created,priority:2" json:"createdAt"`
+	// Relations
+	Actor   *Account `gorm:"foreignKey:ActorId;references:Id" json:"-"`
+	Account *Account `gorm:"foreignKey:AccountId;references:Id" json:"-"`
+}
+
+func (ActivityLog) TableName() string {
+	return "activity_log"
+}
@@ -15,6 +15,10 @@ type Availability struct {
 	Event     Event     `gorm:"foreignKey:EventId;references:Id" json:"-"`
 	StartsAt  time.Time `gorm:"column:starts_at" json:"startsAt"`
 	EndsAt    time.Time `gorm:"column:ends_at" json:"endsAt"`
+	Note      *string   `gorm:"column:note;size:255;default:null" json:"note"`
+	// Account that entered the availability on behalf of the participant, nil when self-entered
+	ProxyAccountId *uuid.UUID `gorm:"column:proxy_account_id;type:uuid;default:null" json:"-"`
+	IsProxied      bool       `gorm:"-" json:"isProxied"`
 }
 
 func (Availability) TableName() string {
@@ -25,5 +29,6 @@ func (a *Availability) Sanitized() *Availability {
 	if a.Account.UserName != nil {
 		a.UserName = *a.Account.UserName
 	}
+	a.IsProxied = a.ProxyAccountId != nil
 	return a
 }
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Comment is a message of the discussion thread of an event.
+// Deleted comments are kept without their body so that the thread stays readable.
+type Comment struct {
+	Id        uuid.UUID  `gorm:"column:id;type:uuid;unique;primary_key" json:"id"`
+	EventId   uuid.UUID  `gorm:"column:event_id;type:uuid;not null;index:idx_comment_event_created,priority:1" json:"-"`
+	AuthorId  uuid.UUID  `gorm:"column:author_id;type:uuid;not null" json:"-"`
+	Body      string     `gorm:"column:body;type:text;not null" json:"body"`
+	CreatedAt time.Time  `gorm:"column:created_at;index:idx_comment_event_created,priority:2" json:"createdAt"`
+	EditedAt  *time.Time `gorm:"column:edited_at;default:null" json:"editedAt"`
+	DeletedAt *time.Time `gorm:"column:deleted_at;default:null" json:"deletedAt"`
+	// Member who deleted the comment, the author or the owner of the event moderating the thread
+	DeletedById *uuid.UUID `gorm:"column:deleted_by_id;type:uuid;default:null" json:"-"`
+	// Relations
+	Author Account `gorm:"foreignKey:AuthorId;references:Id" json:"-"`
+}
+
+func (Comment) TableName() string {
+	return "comment"
+}
@@ -9,15 +9,35 @@ import (
 )
 
 type Event struct {
-	Id          uuid.UUID             `gorm:"column:id;type:uuid;unique;primary_key" json:"id"`
-	Name        string                `gorm:"column:name;size:255" json:"name"`
-	Description *string               `gorm:"column:description;type:text" json:"description"`
-	Duration    int                   `gorm:"column:duration;default:60" json:"duration"` // In minutes
-	StartsAt    time.Time             `gorm:"column:starts_at" json:"startsAt"`
-	EndsAt      time.Time             `gorm:"column:ends_at" json:"endsAt"`
-	CreatedAt   time.Time             `gorm:"column:created_at;default:CURRENT_TIMESTAMP" json:"createdAt"`
-	OwnerId     uuid.UUID             `gorm:"column:owner_id;type:uuid;primaryKey" json:"-"`
-	Status      constants.EventStatus `gorm:"type:event_status;column:status" json:"status"`
+	Id               uuid.UUID             `gorm:"column:id;type:uuid;unique;primary_key" json:"id"`
+	Name             string                `gorm:"column:name;size:255" json:"name"`
+	Description      *string               `gorm:"column:description;type:text" json:"description"`
+	Duration         int                   `gorm:"column:duration;default:60" json:"duration"` // In minutes
+	StartsAt         time.Time             `gorm:"column:starts_at" json:"startsAt"`
+	EndsAt           time.Time             `gorm:"column:ends_at" json:"endsAt"`
+	CreatedAt        time.Time             `gorm:"column:created_at;default:CURRENT_TIMESTAMP" json:"createdAt"`
+	OwnerId          uuid.UUID             `gorm:"column:owner_id;type:uuid;primaryKey" json:"-"`
+	Status           constants.EventStatus `gorm:"type:event_status;column:status" json:"status"`
+	InviteOnly       bool                  `gorm:"column:invite_only;default:false" json:"inviteOnly"`             // Joining requires an invitation link. Set by default on creation, the events created before keep the false migration default
+	RequiresApproval bool                  `gorm:"column:requires_approval;default:false" json:"requiresApproval"` // Joining requires the owner's approval
+	PendingOwnerId   *uuid.UUID            `gorm:"column:pending_owner_id;type:uuid;default:null" json:"-"`        // Member nominated to take over the event
+	CancelledAt      *time.Time            `gorm:"column:cancelled_at;default:null" json:"cancelledAt"`
+	CancelReason     *string               `gorm:"column:cancel_reason;size:500;default:null" json:"cancelReason"`
+	Address          *string               `gorm:"column:address;size:255;default:null" json:"address"`           // Physical place of the event
+	MeetingUrl       *string               `gorm:"column:meeting_url;size:500;default:null" json:"meetingUrl"`    // Online meeting link of the event
+	AutoMeetingLink  bool                  `gorm:"column:auto_meeting_link;default:false" json:"autoMeetingLink"` // Generate the meeting link when a slot is confirmed
+	AutoConfirm      bool                  `gorm:"column:auto_confirm;default:false" json:"autoConfirm"`          // Confirm the slot once every member answered and a single slot remains
+	DecisionDeadline *time.Time            `gorm:"column:decision_deadline;default:null" json:"decisionDeadline"` // The best slot is confirmed automatically once passed
+	MaxParticipants  *int                  `gorm:"column:max_participants;default:null" json:"maxParticipants"`   // Members including the owner, the next ones are waitlisted
+	Version          int                   `gorm:"column:version;not null;default:1" json:"version"`              // Incremented on every change of the event, for optimistic concurrency
+	// Set by the deadline job so that reminders and the deadline are only handled once
+	DeadlineRemindedAt  *time.Time `gorm:"column:deadline_reminded_at;default:null" json:"-"`
+	DeadlineProcessedAt *time.Time `gorm:"column:deadline_processed_at;default:null" json:"-"`
+	// Validated slot replaced by a reschedule, kept so that the members see what changed
+	PreviousSlotStartsAt *time.Time `gorm:"column:previous_slot_starts_at;default:null" json:"previousSlotStartsAt"`
+	PreviousSlotEndsAt   *time.Time `gorm:"column:previous_slot_ends_at;default:null" json:"previousSlotEndsAt"`
+	// Set while a new slot is searched after a reschedule, the next confirmation is sent as an update
+	RescheduledAt *time.Time `gorm:"column:rescheduled_at;default:null" json:"rescheduledAt"`
 
 	// Relations
 	Owner          Account        `gorm:"foreignKey:OwnerId;references:Id" json:"owner"`
@@ -48,29 +68,47 @@ func (e *Event) Sanitized() *Event {
 	return e
 }
 
-// checks if a user has access to this event by verifying
-// if the user ID exists in the event's AccountEvents relation.
-func (e *Event) HasUserAccess(userId *uuid.UUID) bool {
+// RoleOf returns the role of the user in the event and whether the user is a member.
+// The event owner always has the OWNER role, members without a stored role are participants.
+func (e *Event) RoleOf(userId *uuid.UUID) (constants.EventRole, bool) {
 	if userId == nil {
-		return false
+		return "", false
+	}
+
+	if e.OwnerId == *userId {
+		return constants.EVENT_ROLE_OWNER, true
 	}
 
 	for _, accountEvent := range e.AccountEvents {
-		if accountEvent.AccountId == *userId {
-			return true
+		if accountEvent.AccountId != *userId {
+			continue
 		}
+		if accountEvent.Role == "" || accountEvent.Role == constants.EVENT_ROLE_OWNER {
+			return constants.EVENT_ROLE_PARTICIPANT, true
+		}
+		return accountEvent.Role, true
 	}
 
-	return false
+	return "", false
 }
 
-// checks if the given user ID has administrative privileges
-func (e *Event) IsOwner(userId *uuid.UUID) bool {
-	if userId == nil {
+// Can checks if the role of the user in the event grants the permission
+func (e *Event) Can(userId *uuid.UUID, permission constants.EventPermission) bool {
+	role, isMember := e.RoleOf(userId)
+	if !isMember {
 		return false
 	}
 
-	return e.OwnerId == *userId
+	return role.Grants(permission)
+}
+
+// GetPreviousSlot returns the validated slot replaced by the last reschedule, nil when the event was never rescheduled
+func (e *Event) GetPreviousSlot() *Slot {
+	if e.PreviousSlotStartsAt == nil || e.PreviousSlotEndsAt == nil {
+		return nil
+	}
+
+	return &Slot{EventId: e.Id, StartsAt: *e.PreviousSlotStartsAt, EndsAt: *e.PreviousSlotEndsAt}
 }
 
 // GetValidatedSlot returns the validated slot for the event
@@ -102,6 +140,11 @@ func (e *Event) HasOneOfStatuses(requireOneOfStatuses *[]constants.EventStatus)
 func (e *Event) CheckAndAutoUpdateStatus(updateFunc func(*Event) error, requireOneOfStatuses *[]constants.EventStatus) (hasStatus bool, err error) {
 	slot := e.GetValidatedSlot()
 
+	// Cancelled events keep their status, they never finish
+	if e.Status == constants.EVENT_STATUS_CANCELLED {
+		return e.HasOneOfStatuses(requireOneOfStatuses), nil
+	}
+
 	// Event is still in decision
 	now := time.Now()
 	isEventPassed := now.After(e.EndsAt)
@@ -120,3 +163,12 @@ func (e *Event) CheckAndAutoUpdateStatus(updateFunc func(*Event) error, requireO
 
 	return e.HasOneOfStatuses(requireOneOfStatuses), nil
 }
+
+// LockedError returns the error explaining why the event no longer accepts changes
+func (e *Event) LockedError() error {
+	if e.Status == constants.EVENT_STATUS_CANCELLED {
+		return constants.ERR_EVENT_CANCELLED.Err
+	}
+
+	return constants.ERR_EVENT_ENDED.Err
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventInvitation struct {
+	Id          uuid.UUID  `gorm:"column:id;type:uuid;unique;primary_key" json:"id,omitzero"`
+	EventId     uuid.UUID  `gorm:"column:event_id;type:uuid;not null;index" json:"-"`
+	CreatedById uuid.UUID  `gorm:"column:created_by_id;type:uuid;not null" json:"-"`
+	Token       string     `gorm:"column:token;type:varchar(64);not null;uniqueIndex" json:"token"`
+	ExpiresAt   *time.Time `gorm:"column:expires_at;default:null" json:"expiresAt"`
+	MaxUses     *int       `gorm:"column:max_uses;default:null" json:"maxUses"`
+	UseCount    int        `gorm:"column:use_count;default:0" json:"useCount"`
+	LastUsedAt  *time.Time `gorm:"column:last_used_at;default:null" json:"lastUsedAt"`
+	RevokedAt   *time.Time `gorm:"column:revoked_at;default:null" json:"revokedAt"`
+	CreatedAt   time.Time  `gorm:"column:created_at;default:CURRENT_TIMESTAMP" json:"createdAt"`
+	// Relations
+	Event Event `gorm:"foreignKey:EventId;references:Id" json:"-"`
+}
+
+func (EventInvitation) TableName() string {
+	return "event_invitation"
+}
+
+// IsExpired checks if the invitation expiry date has passed
+func (i *EventInvitation) IsExpired(now time.Time) bool {
+	return i.ExpiresAt != nil && !now.Before(*i.ExpiresAt)
+}
+
+// IsExhausted checks if the invitation reached its maximum number of uses
+func (i *EventInvitation) IsExhausted() bool {
+	return i.MaxUses != nil && i.UseCount >= *i.MaxUses
+}
+
+// IsActive checks if the invitation can still be used to join the event
+func (i *EventInvitation) IsActive(now time.Time) bool {
+	return i.RevokedAt == nil && !i.IsExpired(now) && !i.IsExhausted()
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventTemplate keeps the settings of an event so that the same kind of event can be created again
+type EventTemplate struct {
+	Id          uuid.UUID `gorm:"column:id;type:uuid;unique;primary_key" json:"id,omitzero"`
+	OwnerId     uuid.UUID `gorm:"column:owner_id;type:uuid;not null;index" json:"-"`
+	Name        string    `gorm:"column:name;size:100;not null" json:"name"`
+	EventName   string    `gorm:"column:event_name;size:100;not null" json:"eventName"`
+	Description *string   `gorm:"column:description;size:500;default:null" json:"description"`
+	// Duration of the slots in minutes
+	Duration int `gorm:"column:duration;not null" json:"duration"`
+	// Length of the search period in minutes, from the start to the end of the event
+	Period           int       `gorm:"column:period;not null" json:"period"`
+	InviteOnly       bool      `gorm:"column:invite_only;default:false" json:"inviteOnly"`
+	RequiresApproval bool      `gorm:"column:requires_approval;default:false" json:"requiresApproval"`
+	Address          *string   `gorm:"column:address;size:255;default:null" json:"address"`
+	MeetingUrl       *string   `gorm:"column:meeting_url;size:500;default:null" json:"meetingUrl"`
+	AutoMeetingLink  bool      `gorm:"column:auto_meeting_link;default:false" json:"autoMeetingLink"`
+	AutoConfirm      bool      `gorm:"column:auto_confirm;default:false" json:"autoConfirm"`
+	MaxParticipants  *int      `gorm:"column:max_participants;default:null" json:"maxParticipants"`
+	CreatedAt        time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP" json:"createdAt"`
+	// Relations
+	Owner Account `gorm:"foreignKey:OwnerId;references:Id" json:"-"`
+}
+
+func (EventTemplate) TableName() string {
+	return "event_template"
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"app/commons/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JoinRequest is a pending membership of an event that requires the owner's approval
+type JoinRequest struct {
+	Id           uuid.UUID                   `gorm:"column:id;type:uuid;unique;primary_key" json:"id,omitzero"`
+	EventId      uuid.UUID                   `gorm:"column:event_id;type:uuid;not null;index" json:"-"`
+	AccountId    uuid.UUID                   `gorm:"column:account_id;type:uuid;not null;index" json:"-"`
+	InvitationId *uuid.UUID                  `gorm:"column:invitation_id;type:uuid;default:null" json:"-"`
+	Status       constants.JoinRequestStatus `gorm:"column:status;type:VARCHAR(20);default:'PENDING'" json:"status"`
+	CreatedAt    time.Time                   `gorm:"column:created_at;default:CURRENT_TIMESTAMP" json:"createdAt"`
+	DecidedAt    *time.Time                  `gorm:"column:decided_at;default:null" json:"decidedAt"`
+	// Relations
+	Account Account `gorm:"foreignKey:AccountId;references:Id" json:"account"`
+	Event   Event   `gorm:"foreignKey:EventId;references:Id" json:"-"`
+}
+
+func (JoinRequest) TableName() string {
+	return "join_request"
+}
+
+func (jr *JoinRequest) IsPending() bool {
+	return jr.Status == constants.JOIN_REQUEST_STATUS_PENDING
+}
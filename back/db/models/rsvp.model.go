@@ -0,0 +1,22 @@
+package model
+
+import (
+	"app/commons/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Rsvp is the answer of a member about attending the validated slot of an event.
+// Answers belong to the slot, a new validated slot starts with no answer.
+type Rsvp struct {
+	SlotId    uuid.UUID            `gorm:"column:slot_id;type:uuid;primaryKey" json:"-"`
+	AccountId uuid.UUID            `gorm:"column:account_id;type:uuid;primaryKey" json:"-"`
+	EventId   uuid.UUID            `gorm:"column:event_id;type:uuid;not null;index" json:"-"`
+	Status    constants.RsvpStatus `gorm:"column:status;type:VARCHAR(20);not null" json:"status"`
+	UpdatedAt time.Time            `gorm:"column:updated_at;default:CURRENT_TIMESTAMP" json:"updatedAt"`
+}
+
+func (Rsvp) TableName() string {
+	return "rsvp"
+}
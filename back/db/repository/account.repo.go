@@ -39,6 +39,7 @@ type AccountCreateDto struct {
 	TermsVersion *string
 	Providers    []model.AccountProvider
 	TimeZone     time.Location
+	IsGuest      bool
 }
 
 func (r *AccountRepository) Create(data AccountCreateDto, account *model.Account) error {
@@ -53,6 +54,7 @@ func (r *AccountRepository) Create(data AccountCreateDto, account *model.Account
 		Providers:    data.Providers,
 		TermsVersion: data.TermsVersion,
 		TimeZone:     data.TimeZone.String(),
+		IsGuest:      data.IsGuest,
 	}
 	if account.TermsVersion != nil {
 		now := time.Now().UTC()
@@ -77,6 +79,42 @@ func (r *AccountRepository) Create(data AccountCreateDto, account *model.Account
 	return nil
 }
 
+// Turns a guest account into a full account, keeping its id and therefore its events and availabilities
+func (r *AccountRepository) UpgradeGuest(data AccountCreateDto, account *model.Account) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(data.Password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Error().Err(err).Msg("ACCOUNT_REPOSITORY::UPGRADE_GUEST Failed to hash password")
+		return err
+	}
+	hashedPasswordToString := string(hashedPassword)
+	now := time.Now().UTC()
+
+	account.UserName = data.UserName
+	account.Email = data.Email
+	account.Password = &hashedPasswordToString
+	account.Language = data.Language
+	account.AvatarUrl = data.AvatarUrl
+	account.AvatarData = data.AvatarData
+	account.TermsVersion = data.TermsVersion
+	account.TermsAcceptedAt = &now
+	account.TimeZone = data.TimeZone.String()
+	account.IsGuest = false
+
+	// Select the columns explicitly so is_guest = false and a cleared username are persisted
+	result := r.db.Model(&account).Omit(clause.Associations).Where("is_guest = ?", true).
+		Select("username", "email", "password", "language", "avatar_url", "avatar_data", "terms_version", "terms_accepted_at", "time_zone", "is_guest").
+		Updates(account)
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("ACCOUNT_REPOSITORY::UPGRADE_GUEST Failed to upgrade guest account")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
 func (r *AccountRepository) Updates(account model.Account) error {
 	if account.Password != nil {
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*account.Password), bcrypt.DefaultCost)
@@ -107,7 +145,8 @@ func (r *AccountRepository) FindOneById(id uuid.UUID, account *model.Account) er
 }
 
 func (r *AccountRepository) FindOneByUsername(username string, account *model.Account, excludeId *uuid.UUID) error {
-	query := r.db.Where("LOWER(username) = LOWER(?) AND deleted_at IS NULL", username)
+	// Guest display names do not reserve usernames
+	query := r.db.Where("LOWER(username) = LOWER(?) AND deleted_at IS NULL AND is_guest = ?", username, false)
 
 	if excludeId != nil {
 		query = query.Where("id != ?", excludeId.String())
@@ -133,7 +172,7 @@ func (r *AccountRepository) FindOneByEmail(email string, account *model.Account)
 }
 
 func (r *AccountRepository) FindOneByEmailOrUsername(emailOrUsername string, account *model.Account) error {
-	if err := r.db.Where("(LOWER(email) = LOWER(?) OR LOWER(username) = LOWER(?)) AND deleted_at IS NULL", emailOrUsername, emailOrUsername).Preload("Providers").First(&account).Error; err != nil {
+	if err := r.db.Where("(LOWER(email) = LOWER(?) OR LOWER(username) = LOWER(?)) AND deleted_at IS NULL AND is_guest = ?", emailOrUsername, emailOrUsername, false).Preload("Providers").First(&account).Error; err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			log.Error().Err(err).Msg("ACCOUNT_REPOSITORY::FIND_ONE_BY_EMAIL_OR_USERNAME Failed to find account by email or username")
 		}
@@ -1,9 +1,11 @@
 package repository
 
 import (
+	"app/commons/constants"
 	"app/db"
 	model "app/db/models"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -35,6 +37,50 @@ func (r *AccountEventRepository) Create(accountEvent *model.AccountEvent) error
 	return nil
 }
 
+// checkCapacity locks the event until the end of the transaction and returns constants.ERR_EVENT_FULL.Err
+// when it already has as many members as its maximum, so that concurrent joins cannot exceed it
+func checkCapacity(tx *gorm.DB, eventId uuid.UUID) error {
+	var event model.Event
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "max_participants").
+		Where("id = ?", eventId).
+		First(&event).Error; err != nil {
+		log.Error().Err(err).Msg("ACCOUNT_EVENT_REPOSITORY::CHECK_CAPACITY Failed to lock event")
+		return err
+	}
+	if event.MaxParticipants == nil {
+		return nil
+	}
+
+	var members int64
+	if err := tx.Model(&model.AccountEvent{}).Where("event_id = ?", eventId).Count(&members).Error; err != nil {
+		log.Error().Err(err).Msg("ACCOUNT_EVENT_REPOSITORY::CHECK_CAPACITY Failed to count members")
+		return err
+	}
+	if members >= int64(*event.MaxParticipants) {
+		return constants.ERR_EVENT_FULL.Err
+	}
+
+	return nil
+}
+
+// Join creates the membership when the event is not full.
+// Returns constants.ERR_EVENT_FULL.Err otherwise.
+func (r *AccountEventRepository) Join(accountEvent *model.AccountEvent) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkCapacity(tx, accountEvent.EventId); err != nil {
+			return err
+		}
+
+		if err := tx.Omit(clause.Associations).Create(&accountEvent).Error; err != nil {
+			log.Error().Err(err).Msg("ACCOUNT_EVENT_REPOSITORY::JOIN Failed to create account_event")
+			return err
+		}
+
+		return nil
+	})
+}
+
 func (r *AccountEventRepository) Updates(accountEvent *model.AccountEvent) error {
 	if err := r.db.Where("account_id = ? AND event_id = ?", accountEvent.AccountId, accountEvent.EventId).Omit(clause.Associations).Updates(&accountEvent).Error; err != nil {
 		log.Error().Err(err).Msg("ACCOUNT_EVENT_REPOSITORY::UPDATES Failed to update account_event")
@@ -71,3 +117,94 @@ func (r *AccountEventRepository) FindAccountsByEventId(eventId uuid.UUID, accoun
 
 	return nil
 }
+
+// Delete removes the membership of an account, its availabilities and its RSVPs in a single transaction,
+// and withdraws the ownership nomination of the account if any.
+// Returns gorm.ErrRecordNotFound when the account is not a member of the event.
+func (r *AccountEventRepository) Delete(accountId, eventId uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("account_id = ? AND event_id = ?", accountId, eventId).Delete(&model.AccountEvent{})
+		if result.Error != nil {
+			log.Error().Err(result.Error).Msg("ACCOUNT_EVENT_REPOSITORY::DELETE Failed to delete account_event")
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Where("account_id = ? AND event_id = ?", accountId, eventId).Delete(&model.Availability{}).Error; err != nil {
+			log.Error().Err(err).Msg("ACCOUNT_EVENT_REPOSITORY::DELETE Failed to delete availabilities")
+			return err
+		}
+
+		if err := tx.Where("account_id = ? AND event_id = ?", accountId, eventId).Delete(&model.Rsvp{}).Error; err != nil {
+			log.Error().Err(err).Msg("ACCOUNT_EVENT_REPOSITORY::DELETE Failed to delete rsvps")
+			return err
+		}
+
+		if err := tx.Model(&model.Event{}).
+			Where("id = ? AND pending_owner_id = ?", eventId, accountId).
+			Update("pending_owner_id", nil).Error; err != nil {
+			log.Error().Err(err).Msg("ACCOUNT_EVENT_REPOSITORY::DELETE Failed to withdraw ownership nomination")
+			return err
+		}
+
+		return nil
+	})
+}
+
+// SetCommentDigest subscribes the member to the digest of the new comments of the event, from the given date,
+// or unsubscribes them. Subscribing again keeps the current period.
+// Returns gorm.ErrRecordNotFound when the account is not a member of the event.
+func (r *AccountEventRepository) SetCommentDigest(accountId, eventId uuid.UUID, enabled bool, since time.Time) error {
+	values := map[string]any{"comment_digest": false, "comment_digest_sent_at": nil}
+	if enabled {
+		values = map[string]any{"comment_digest": true, "comment_digest_sent_at": gorm.Expr("COALESCE(comment_digest_sent_at, ?)", since)}
+	}
+
+	result := r.db.Model(&model.AccountEvent{}).
+		Where("account_id = ? AND event_id = ?", accountId, eventId).
+		Updates(values)
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("ACCOUNT_EVENT_REPOSITORY::SET_COMMENT_DIGEST Failed to update comment digest")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// FindDueCommentDigests returns the subscribed memberships whose last digest was sent at or before the given date,
+// with their account and event
+func (r *AccountEventRepository) FindDueCommentDigests(sentBefore time.Time) ([]model.AccountEvent, error) {
+	var accountEvents []model.AccountEvent
+	if err := r.db.
+		Preload("Account").
+		Preload("Event").
+		Where("comment_digest = ? AND comment_digest_sent_at <= ?", true, sentBefore).
+		Find(&accountEvents).Error; err != nil {
+		log.Error().Err(err).Msg("ACCOUNT_EVENT_REPOSITORY::FIND_DUE_COMMENT_DIGESTS Failed to find due comment digests")
+		return nil, err
+	}
+
+	return accountEvents, nil
+}
+
+// MarkCommentDigestSent records that the digest of the member was sent.
+// Returns gorm.ErrRecordNotFound when it was already recorded since the previous one, so that concurrent jobs send it once.
+func (r *AccountEventRepository) MarkCommentDigestSent(accountId, eventId uuid.UUID, previous time.Time, sentAt time.Time) error {
+	result := r.db.Model(&model.AccountEvent{}).
+		Where("account_id = ? AND event_id = ? AND comment_digest = ? AND comment_digest_sent_at = ?", accountId, eventId, true, previous).
+		Update("comment_digest_sent_at", sentAt)
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("ACCOUNT_EVENT_REPOSITORY::MARK_COMMENT_DIGEST_SENT Failed to mark comment digest")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
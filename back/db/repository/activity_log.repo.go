@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"app/db"
+	model "app/db/models"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type ActivityLogRepository struct {
+	db *gorm.DB
+}
+
+func NewActivityLogRepository(database *gorm.DB) *ActivityLogRepository {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &ActivityLogRepository{
+		db: database,
+	}
+}
+
+// Create appends the entry to the activity log, entries are never updated afterwards
+func (r *ActivityLogRepository) Create(activityLog *model.ActivityLog) error {
+	if err := r.db.Omit("Actor", "Account").Create(activityLog).Error; err != nil {
+		log.Error().Err(err).Str("eventId", activityLog.EventId.String()).Msg("ACTIVITY_LOG_REPOSITORY::CREATE Failed to create activity log")
+		return err
+	}
+
+	return nil
+}
+
+// FindOneById returns the entry with its actor and the member concerned
+func (r *ActivityLogRepository) FindOneById(id uuid.UUID, activityLog *model.ActivityLog) error {
+	if err := r.db.Preload("Actor").Preload("Account").Where("id = ?", id).First(activityLog).Error; err != nil {
+		log.Error().Err(err).Str("id", id.String()).Msg("ACTIVITY_LOG_REPOSITORY::FIND_ONE_BY_ID Failed to find activity log")
+		return err
+	}
+
+	return nil
+}
+
+func (r *ActivityLogRepository) CountByEventId(eventId uuid.UUID) (int64, error) {
+	var total int64
+	if err := r.db.Model(&model.ActivityLog{}).Where("event_id = ?", eventId).Count(&total).Error; err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("ACTIVITY_LOG_REPOSITORY::COUNT_BY_EVENT_ID Failed to count activity logs")
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// FindByEventId returns a page of the activity log of the event with the actors and the members concerned, the newest entries first,
+// starting after the cursor when given, at the offset otherwise
+func (r *ActivityLogRepository) FindByEventId(eventId uuid.UUID, after *CreatedAtCursor, limit int, offset int) ([]model.ActivityLog, error) {
+	query := r.db.Preload("Actor").Preload("Account").Where("event_id = ?", eventId)
+
+	var activityLogs []model.ActivityLog
+	if err := newestFirst(query, after, limit, offset).Find(&activityLogs).Error; err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("ACTIVITY_LOG_REPOSITORY::FIND_BY_EVENT_ID Failed to find activity logs")
+		return nil, err
+	}
+
+	return activityLogs, nil
+}
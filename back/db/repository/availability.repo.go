@@ -105,13 +105,34 @@ func (r *AvailabilityRepository) FindByEventId(eventId uuid.UUID, availabilities
 	return nil
 }
 
+// DeleteByEventId removes the availabilities of every member of the event
+func (r *AvailabilityRepository) DeleteByEventId(eventId uuid.UUID) error {
+	if err := r.db.Where("event_id = ?", eventId).Delete(&model.Availability{}).Error; err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("AVAILABILITY_REPOSITORY::DELETE_BY_EVENT_ID Failed to delete availabilities by event ID")
+		return err
+	}
+
+	return nil
+}
+
+// retrieves all availabilities of an account for a given event ID
+func (r *AvailabilityRepository) FindByAccountAndEventId(accountId uuid.UUID, eventId uuid.UUID, availabilities *[]model.Availability) error {
+	if err := r.db.Where("account_id = ? AND event_id = ?", accountId, eventId).Order("starts_at ASC").Find(&availabilities).Error; err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("AVAILABILITY_REPOSITORY::FIND_BY_ACCOUNT_AND_EVENT_ID Failed to get availabilities by account and event ID")
+		return err
+	}
+
+	return nil
+}
+
 // Updates an availability
 func (r *AvailabilityRepository) Update(availability *model.Availability) error {
 	if availability == nil {
 		return errors.New("availability pointer is nil")
 	}
 
-	if err := r.db.Model(&availability).Omit(clause.Associations).Updates(availability).Error; err != nil {
+	// Select the editable columns explicitly so a removed note or proxy is persisted as NULL
+	if err := r.db.Model(&availability).Omit(clause.Associations).Select("starts_at", "ends_at", "note", "proxy_account_id").Updates(availability).Error; err != nil {
 		log.Error().Err(err).Msg("AVAILABILITY_REPOSITORY::UPDATE Failed to update availability")
 		return err
 	}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"app/db"
+	model "app/db/models"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type CommentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(database *gorm.DB) *CommentRepository {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &CommentRepository{
+		db: database,
+	}
+}
+
+func (r *CommentRepository) Create(comment *model.Comment) error {
+	if err := r.db.Omit("Author").Create(comment).Error; err != nil {
+		log.Error().Err(err).Str("eventId", comment.EventId.String()).Msg("COMMENT_REPOSITORY::CREATE Failed to create comment")
+		return err
+	}
+
+	return nil
+}
+
+// FindOneById returns the comment of the event with its author
+func (r *CommentRepository) FindOneById(id uuid.UUID, eventId uuid.UUID, comment *model.Comment) error {
+	if err := r.db.Preload("Author").Where("id = ? AND event_id = ?", id, eventId).First(comment).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Str("id", id.String()).Msg("COMMENT_REPOSITORY::FIND_ONE_BY_ID Failed to find comment")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UpdateBody replaces the body of the comment and marks it as edited.
+// Returns gorm.ErrRecordNotFound when the comment was deleted in the meantime.
+func (r *CommentRepository) UpdateBody(id uuid.UUID, body string, editedAt time.Time) error {
+	result := r.db.Model(&model.Comment{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]any{"body": body, "edited_at": editedAt})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Str("id", id.String()).Msg("COMMENT_REPOSITORY::UPDATE_BODY Failed to update comment")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Delete removes the body of the comment and keeps it in the thread as deleted.
+// Returns gorm.ErrRecordNotFound when the comment was already deleted.
+func (r *CommentRepository) Delete(id uuid.UUID, deletedById uuid.UUID, deletedAt time.Time) error {
+	result := r.db.Model(&model.Comment{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]any{"body": "", "deleted_at": deletedAt, "deleted_by_id": deletedById})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Str("id", id.String()).Msg("COMMENT_REPOSITORY::DELETE Failed to delete comment")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *CommentRepository) CountByEventId(eventId uuid.UUID) (int64, error) {
+	var total int64
+	if err := r.db.Model(&model.Comment{}).Where("event_id = ?", eventId).Count(&total).Error; err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("COMMENT_REPOSITORY::COUNT_BY_EVENT_ID Failed to count comments")
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// FindByEventId returns a page of the thread of the event with the authors, the newest comments first,
+// starting after the cursor when given, at the offset otherwise
+func (r *CommentRepository) FindByEventId(eventId uuid.UUID, after *CreatedAtCursor, limit int, offset int) ([]model.Comment, error) {
+	query := r.db.Preload("Author").Where("event_id = ?", eventId)
+
+	var comments []model.Comment
+	if err := newestFirst(query, after, limit, offset).Find(&comments).Error; err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("COMMENT_REPOSITORY::FIND_BY_EVENT_ID Failed to find comments")
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// FindForDigest returns the comments of the event posted in [since, until) by other members than the reader
+// and not deleted since, the oldest first
+func (r *CommentRepository) FindForDigest(eventId uuid.UUID, readerId uuid.UUID, since time.Time, until time.Time) ([]model.Comment, error) {
+	var comments []model.Comment
+	if err := r.db.Preload("Author").
+		Where("event_id = ? AND author_id <> ? AND deleted_at IS NULL", eventId, readerId).
+		Where("created_at >= ? AND created_at < ?", since, until).
+		Order("created_at ASC").Order("id ASC").
+		Find(&comments).Error; err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("COMMENT_REPOSITORY::FIND_FOR_DIGEST Failed to find comments")
+		return nil, err
+	}
+
+	return comments, nil
+}
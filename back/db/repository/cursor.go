@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CreatedAtCursor is the position of a row in a list ordered by creation date then id, the newest rows first
+type CreatedAtCursor struct {
+	Id        uuid.UUID `json:"i"`
+	CreatedAt time.Time `json:"c"`
+}
+
+// NewCreatedAtCursor returns the position of the row with the given id and creation date
+func NewCreatedAtCursor(id uuid.UUID, createdAt time.Time) CreatedAtCursor {
+	return CreatedAtCursor{Id: id, CreatedAt: createdAt}
+}
+
+// newestFirst orders the query by creation date then id, the newest rows first, and selects a page of it
+// starting after the cursor when given, at the offset otherwise
+func newestFirst(query *gorm.DB, after *CreatedAtCursor, limit int, offset int) *gorm.DB {
+	if after != nil {
+		query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", after.CreatedAt, after.CreatedAt, after.Id)
+	} else {
+		query = query.Offset(offset)
+	}
+
+	return query.Order("created_at DESC").Order("id DESC").Limit(limit)
+}
@@ -4,6 +4,11 @@ import (
 	"app/commons/constants"
 	"app/db"
 	model "app/db/models"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -35,8 +40,18 @@ func (r *EventRepository) Create(event *model.Event) error {
 	return nil
 }
 
+// nextVersion is the update expression of the version column, every change of an event goes through it
+var nextVersion = gorm.Expr("version + 1")
+
+// Updates writes the non-zero fields of the event and increments its version, then reloads it
 func (r *EventRepository) Updates(event *model.Event) error {
-	if err := r.db.Omit(clause.Associations).Updates(&event).Error; err != nil {
+	if err := r.db.Transaction(func(tx *gorm.DB) error {
+		// The version of a loaded event may be stale, it is only ever incremented
+		if err := tx.Omit(clause.Associations, "version").Updates(&event).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.Event{}).Where("id = ?", event.Id).UpdateColumn("version", nextVersion).Error
+	}); err != nil {
 		log.Error().Err(err).Msg("EVENT_REPOSITORY::UPDATE Failed to update event")
 		return err
 	}
@@ -44,6 +59,50 @@ func (r *EventRepository) Updates(event *model.Event) error {
 	return r.FindOneById(event.Id, event)
 }
 
+// UpdateColumns updates the given columns, including zero values that Updates skips, and increments the version
+func (r *EventRepository) UpdateColumns(eventId uuid.UUID, columns map[string]any) error {
+	columns = maps.Clone(columns)
+	columns["version"] = nextVersion
+
+	if err := r.db.Model(&model.Event{}).Where("id = ?", eventId).Updates(columns).Error; err != nil {
+		log.Error().Err(err).Msg("EVENT_REPOSITORY::UPDATE_COLUMNS Failed to update event columns")
+		return err
+	}
+
+	return nil
+}
+
+// UpdateColumnsAtVersion updates the given columns and increments the version in a single statement, then reloads the event.
+// When version is set, the update only applies if the event is still at that version, so that a single writer wins
+// among those who read the same version. Returns gorm.ErrRecordNotFound when the event changed in the meantime.
+func (r *EventRepository) UpdateColumnsAtVersion(event *model.Event, columns map[string]any, version *int) error {
+	columns = maps.Clone(columns)
+	columns["version"] = nextVersion
+
+	if err := r.db.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&model.Event{}).Where("id = ?", event.Id)
+		if version != nil {
+			query = query.Where("version = ?", *version)
+		}
+		result := query.Updates(columns)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return NewEventRepository(tx).FindOneById(event.Id, event)
+	}); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("EVENT_REPOSITORY::UPDATE_COLUMNS_AT_VERSION Failed to update event columns")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (r *EventRepository) FindOneById(
 	eventId uuid.UUID,
 	event *model.Event,
@@ -75,60 +134,234 @@ func (r *EventRepository) FindOneById(
 	return nil
 }
 
+// EventListFilter narrows down and orders the events of an account
+type EventListFilter struct {
+	Statuses []constants.EventStatus
+	// "owned" keeps the events owned by the account, "joined" the other ones
+	Ownership string
+	// Keeps the events whose period overlaps the range
+	From *time.Time
+	To   *time.Time
+	// Full-text search on the name and the description
+	Search string
+	// "status" (default), "next_slot", "created_at" or "name"
+	Sort string
+	Desc bool
+	// Keeps the events after this position in the sort order, in place of the offset
+	After *EventListCursor
+}
+
+// EventListCursor is the position of an event in the event list, for every sort option
+type EventListCursor struct {
+	Id         uuid.UUID  `json:"i"`
+	SortOrder  int        `json:"o"`
+	Name       string     `json:"n"`
+	CreatedAt  time.Time  `json:"c"`
+	NextSlotAt *time.Time `json:"s,omitempty"`
+}
+
+// eventStatusSortOrders orders the events by status in the default sort, other statuses come last
+var eventStatusSortOrders = []constants.EventStatus{
+	constants.EVENT_STATUS_IN_DECISION,
+	constants.EVENT_STATUS_UPCOMING,
+	constants.EVENT_STATUS_FINISHED,
+}
+
+// noNextSlotAt stands for the missing confirmed slot in the keyset, the events concerned are ordered by the no_next_slot column first
+var noNextSlotAt = time.Unix(0, 0).UTC()
+
+// NewEventListCursor returns the position of the event, its validated slot must be loaded
+func NewEventListCursor(event model.Event) EventListCursor {
+	cursor := EventListCursor{
+		Id:        event.Id,
+		SortOrder: len(eventStatusSortOrders) + 1,
+		Name:      event.Name,
+		CreatedAt: event.CreatedAt,
+	}
+	for i, status := range eventStatusSortOrders {
+		if event.Status == status {
+			cursor.SortOrder = i + 1
+		}
+	}
+	if slot := event.GetValidatedSlot(); slot != nil {
+		cursor.NextSlotAt = &slot.StartsAt
+	}
+
+	return cursor
+}
+
+// eventSearchDocument is the text indexed by the event_search_idx index, both must stay identical
+const eventSearchDocument = "to_tsvector('simple', coalesce(event.name, '') || ' ' || coalesce(event.description, ''))"
+
+// filteredEventsQuery selects the events of the account matching the filter, without ordering.
+// The status filter is left out when withStatus is false to count the events per status.
+func (r *EventRepository) filteredEventsQuery(accountId uuid.UUID, filter EventListFilter, withStatus bool) *gorm.DB {
+	query := r.db.
+		Table("event").
+		Joins("JOIN account_event ae ON ae.event_id = event.id").
+		Where("ae.account_id = ?", accountId)
+
+	if withStatus && len(filter.Statuses) > 0 {
+		query = query.Where("event.status IN ?", filter.Statuses)
+	}
+
+	switch filter.Ownership {
+	case "owned":
+		query = query.Where("event.owner_id = ?", accountId)
+	case "joined":
+		query = query.Where("event.owner_id <> ?", accountId)
+	}
+
+	if filter.From != nil {
+		query = query.Where("event.ends_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("event.starts_at <= ?", *filter.To)
+	}
+
+	if filter.Search != "" {
+		// The text index only exists on Postgres, other databases fall back to a simple match
+		if r.db.Name() == "postgres" {
+			query = query.Where(eventSearchDocument+" @@ plainto_tsquery('simple', ?)", filter.Search)
+		} else {
+			pattern := "%" + strings.ToLower(filter.Search) + "%"
+			query = query.Where("LOWER(event.name) LIKE ? OR LOWER(COALESCE(event.description, '')) LIKE ?", pattern, pattern)
+		}
+	}
+
+	return query
+}
+
+// keysetColumn is a column of the event list order with the value of the cursor for this column
+type keysetColumn struct {
+	name  string
+	desc  bool
+	value any
+}
+
+// eventListKeyset returns the columns of the sort option, the id keeps the order stable between pages
+func eventListKeyset(filter EventListFilter) []keysetColumn {
+	var after EventListCursor
+	if filter.After != nil {
+		after = *filter.After
+	}
+
+	var columns []keysetColumn
+	switch filter.Sort {
+	case "next_slot":
+		// Events without a confirmed slot come last whatever the direction
+		noNextSlot, nextSlotAt := 0, noNextSlotAt
+		if after.NextSlotAt == nil {
+			noNextSlot = 1
+		} else {
+			nextSlotAt = *after.NextSlotAt
+		}
+		columns = []keysetColumn{{"e.no_next_slot", false, noNextSlot}, {"e.next_slot_at", filter.Desc, nextSlotAt}}
+	case "created_at":
+		columns = []keysetColumn{{"e.created_at", filter.Desc, after.CreatedAt}}
+	case "name":
+		columns = []keysetColumn{{"e.name", filter.Desc, after.Name}}
+	default:
+		columns = []keysetColumn{{"e.sort_order", filter.Desc, after.SortOrder}, {"e.name", false, after.Name}}
+	}
+
+	return append(columns, keysetColumn{"e.id", false, after.Id})
+}
+
+// keysetCondition builds the condition keeping the rows after the cursor values in the order of the columns
+func keysetCondition(columns []keysetColumn) (string, []any) {
+	var conditions []string
+	var args []any
+	for i, column := range columns {
+		var parts []string
+		for _, previous := range columns[:i] {
+			parts = append(parts, previous.name+" = ?")
+			args = append(args, previous.value)
+		}
+		operator := " > ?"
+		if column.desc {
+			operator = " < ?"
+		}
+		parts = append(parts, column.name+operator)
+		args = append(args, column.value)
+		conditions = append(conditions, "("+strings.Join(parts, " AND ")+")")
+	}
+
+	return strings.Join(conditions, " OR "), args
+}
+
+// CountEventsByAccountId counts the events of the account matching the filter
+func (r *EventRepository) CountEventsByAccountId(accountId uuid.UUID, filter EventListFilter) (int64, error) {
+	var total int64
+	if err := r.filteredEventsQuery(accountId, filter, true).
+		Select("COUNT(DISTINCT event.id)").
+		Count(&total).
+		Error; err != nil {
+		log.Error().Err(err).Msg("EVENT_REPOSITORY::COUNT_EVENTS_BY_ACCOUNT_ID Failed to count events")
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// FindEventsByAccountId returns a page of the events of the account matching the filter,
+// starting after the cursor of the filter when given, at the offset otherwise
 func (r *EventRepository) FindEventsByAccountId(
 	accountId uuid.UUID,
+	filter EventListFilter,
 	limit int,
 	offset int,
-) ([]model.Event, int64, error) {
+) ([]model.Event, error) {
 	db := r.db
 
-	// Count
-	var total int64
-	if err := db.
-		Table("account_event").
-		Select("COUNT(DISTINCT event_id)").
-		Where("account_id = ?", accountId).
-		Count(&total).
-		Error; err != nil {
-		return nil, 0, err
+	sortOrder := make([]string, 0, len(eventStatusSortOrders))
+	sortOrderArgs := make([]any, 0, len(eventStatusSortOrders)+1)
+	for i, status := range eventStatusSortOrders {
+		sortOrder = append(sortOrder, fmt.Sprintf("WHEN event.status = ? THEN %d", i+1))
+		sortOrderArgs = append(sortOrderArgs, status)
 	}
 
 	// Get paginated event IDs
-	subQuery := db.
-		Table("event").
-		Select(`
+	nextSlotAt := "(SELECT MIN(s.starts_at) FROM slot s WHERE s.event_id = event.id AND s.is_validated = ?)"
+	subQuery := r.filteredEventsQuery(accountId, filter, true).
+		Select(fmt.Sprintf(`
 			event.id,
 			event.name,
 			event.status,
-			CASE
-				WHEN event.status = ? THEN 1
-				WHEN event.status = ? THEN 2
-				WHEN event.status = ? THEN 3
-				ELSE 4
-			END AS sort_order
-		`,
-			constants.EVENT_STATUS_IN_DECISION,
-			constants.EVENT_STATUS_UPCOMING,
-			constants.EVENT_STATUS_FINISHED,
-		).
-		Joins("JOIN account_event ae ON ae.event_id = event.id").
-		Where("ae.account_id = ?", accountId)
+			event.created_at,
+			CASE WHEN %[1]s IS NULL THEN 1 ELSE 0 END AS no_next_slot,
+			COALESCE(%[1]s, ?) AS next_slot_at,
+			CASE %[2]s ELSE %[3]d END AS sort_order
+		`, nextSlotAt, strings.Join(sortOrder, " "), len(eventStatusSortOrders)+1),
+			append([]any{true, true, noNextSlotAt}, sortOrderArgs...)...,
+		)
+
+	keyset := eventListKeyset(filter)
+	eventIdsQuery := db.Table("(?) AS e", subQuery)
+	if filter.After != nil {
+		condition, args := keysetCondition(keyset)
+		eventIdsQuery = eventIdsQuery.Where(condition, args...)
+	} else {
+		eventIdsQuery = eventIdsQuery.Offset(offset)
+	}
+	for _, column := range keyset {
+		direction := " ASC"
+		if column.desc {
+			direction = " DESC"
+		}
+		eventIdsQuery = eventIdsQuery.Order(column.name + direction)
+	}
 
 	var eventIds []uuid.UUID
-	if err := db.
-		Table("(?) AS e", subQuery).
-		Order("e.sort_order ASC").
-		Order("e.name ASC").
-		Order("e.id ASC").
+	if err := eventIdsQuery.
 		Limit(limit).
-		Offset(offset).
 		Pluck("e.id", &eventIds).
 		Error; err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	if len(eventIds) == 0 {
-		return []model.Event{}, total, nil
+		return []model.Event{}, nil
 	}
 
 	// Fetch full event details
@@ -137,9 +370,10 @@ func (r *EventRepository) FindEventsByAccountId(
 		Where("id IN ?", eventIds).
 		Preload("Owner").
 		Preload("AccountEvents.Account").
+		Preload("Slots", "is_validated = ?", true).
 		Find(&events).
 		Error; err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	// Map events by ID
@@ -166,7 +400,33 @@ func (r *EventRepository) FindEventsByAccountId(
 		}
 	}
 
-	return orderedEvents, total, nil
+	return orderedEvents, nil
+}
+
+// CountEventsByStatus counts the events of the account matching the filter for each status, ignoring the status filter
+func (r *EventRepository) CountEventsByStatus(accountId uuid.UUID, filter EventListFilter) (map[constants.EventStatus]int64, error) {
+	var rows []struct {
+		Status constants.EventStatus
+		Count  int64
+	}
+	if err := r.filteredEventsQuery(accountId, filter, false).
+		Select("event.status AS status, COUNT(DISTINCT event.id) AS count").
+		Group("event.status").
+		Scan(&rows).
+		Error; err != nil {
+		log.Error().Err(err).Msg("EVENT_REPOSITORY::COUNT_EVENTS_BY_STATUS Failed to count events by status")
+		return nil, err
+	}
+
+	counts := make(map[constants.EventStatus]int64, len(constants.EventStatuses))
+	for _, status := range constants.EventStatuses {
+		counts[status] = 0
+	}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+
+	return counts, nil
 }
 
 func (r *EventRepository) Delete(id uuid.UUID) error {
@@ -177,3 +437,169 @@ func (r *EventRepository) Delete(id uuid.UUID) error {
 
 	return nil
 }
+
+// TransferOwnership makes the new owner own the event and demotes the previous owner to organiser in a single transaction.
+// Returns gorm.ErrRecordNotFound when the owner changed meanwhile or the new owner is not a member.
+func (r *EventRepository) TransferOwnership(eventId, previousOwnerId, newOwnerId uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Event{}).
+			Where("id = ? AND owner_id = ?", eventId, previousOwnerId).
+			Updates(map[string]any{"owner_id": newOwnerId, "pending_owner_id": nil, "version": nextVersion})
+		if result.Error != nil {
+			log.Error().Err(result.Error).Msg("EVENT_REPOSITORY::TRANSFER_OWNERSHIP Failed to update event owner")
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		result = tx.Model(&model.AccountEvent{}).
+			Where("event_id = ? AND account_id = ?", eventId, newOwnerId).
+			Update("role", constants.EVENT_ROLE_OWNER)
+		if result.Error != nil {
+			log.Error().Err(result.Error).Msg("EVENT_REPOSITORY::TRANSFER_OWNERSHIP Failed to promote new owner")
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Model(&model.AccountEvent{}).
+			Where("event_id = ? AND account_id = ?", eventId, previousOwnerId).
+			Update("role", constants.EVENT_ROLE_ORGANISER).Error; err != nil {
+			log.Error().Err(err).Msg("EVENT_REPOSITORY::TRANSFER_OWNERSHIP Failed to demote previous owner")
+			return err
+		}
+
+		return nil
+	})
+}
+
+// FindEventsToRemind returns the events still in decision whose decision deadline falls before the given limit
+// and whose participants have not been reminded yet
+func (r *EventRepository) FindEventsToRemind(now time.Time, limit time.Time) ([]model.Event, error) {
+	var events []model.Event
+	if err := r.db.
+		Where("status = ? AND deadline_reminded_at IS NULL", constants.EVENT_STATUS_IN_DECISION).
+		Where("decision_deadline > ? AND decision_deadline <= ?", now, limit).
+		Find(&events).Error; err != nil {
+		log.Error().Err(err).Msg("EVENT_REPOSITORY::FIND_EVENTS_TO_REMIND Failed to find events to remind")
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// FindEventsPastDeadline returns the events still in decision whose decision deadline has passed and was not handled yet
+func (r *EventRepository) FindEventsPastDeadline(now time.Time) ([]model.Event, error) {
+	var events []model.Event
+	if err := r.db.
+		Where("status = ? AND deadline_processed_at IS NULL", constants.EVENT_STATUS_IN_DECISION).
+		Where("decision_deadline <= ?", now).
+		Find(&events).Error; err != nil {
+		log.Error().Err(err).Msg("EVENT_REPOSITORY::FIND_EVENTS_PAST_DEADLINE Failed to find events past their deadline")
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// MarkDeadlineReminded records that the participants were reminded of the deadline of the event.
+// Returns gorm.ErrRecordNotFound when it was already recorded, so that concurrent jobs remind them once.
+func (r *EventRepository) MarkDeadlineReminded(eventId uuid.UUID, remindedAt time.Time) error {
+	return r.markDeadlineStep(eventId, "deadline_reminded_at", remindedAt)
+}
+
+// MarkDeadlineProcessed records that the deadline of the event was handled.
+// Returns gorm.ErrRecordNotFound when it was already recorded, so that concurrent jobs handle it once.
+func (r *EventRepository) MarkDeadlineProcessed(eventId uuid.UUID, processedAt time.Time) error {
+	return r.markDeadlineStep(eventId, "deadline_processed_at", processedAt)
+}
+
+// ClearDeadlineProcessed forgets that the deadline of the event was handled, so that the next check handles it again
+func (r *EventRepository) ClearDeadlineProcessed(eventId uuid.UUID) error {
+	if err := r.db.Model(&model.Event{}).Where("id = ?", eventId).Update("deadline_processed_at", nil).Error; err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("EVENT_REPOSITORY::CLEAR_DEADLINE_PROCESSED Failed to clear deadline processing")
+		return err
+	}
+
+	return nil
+}
+
+func (r *EventRepository) markDeadlineStep(eventId uuid.UUID, column string, at time.Time) error {
+	result := r.db.Model(&model.Event{}).
+		Where("id = ? AND "+column+" IS NULL", eventId).
+		Update(column, at)
+	if result.Error != nil {
+		log.Error().Err(result.Error).Str("column", column).Msg("EVENT_REPOSITORY::MARK_DEADLINE_STEP Failed to mark deadline step")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// FindEventsToFinish returns the events still open whose period or validated slot has ended
+func (r *EventRepository) FindEventsToFinish(now time.Time) ([]model.Event, error) {
+	var events []model.Event
+	if err := r.db.
+		Where("status IN ?", []constants.EventStatus{constants.EVENT_STATUS_IN_DECISION, constants.EVENT_STATUS_UPCOMING}).
+		Where("ends_at < ? OR EXISTS (SELECT 1 FROM slot WHERE slot.event_id = event.id AND slot.is_validated = ? AND slot.ends_at < ?)", now, true, now).
+		Find(&events).Error; err != nil {
+		log.Error().Err(err).Msg("EVENT_REPOSITORY::FIND_EVENTS_TO_FINISH Failed to find events to finish")
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// TransitionStatus moves the event to the given status when its current status is one of the given ones,
+// and returns the status it had. Returns gorm.ErrRecordNotFound when it has none of them,
+// so that concurrent writers make the transition once.
+func (r *EventRepository) TransitionStatus(eventId uuid.UUID, from []constants.EventStatus, to constants.EventStatus) (constants.EventStatus, error) {
+	var previous model.Event
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "status").
+			Where("id = ? AND status IN ?", eventId, from).
+			First(&previous).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&model.Event{}).
+			Where("id = ?", eventId).
+			Updates(map[string]any{"status": to, "version": nextVersion}).Error
+	})
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Str("eventId", eventId.String()).Msg("EVENT_REPOSITORY::TRANSITION_STATUS Failed to update event status")
+		}
+		return "", err
+	}
+
+	return previous.Status, nil
+}
+
+// Cancel sets the event as cancelled with the optional reason, only while the event is still open.
+// Returns gorm.ErrRecordNotFound when the event is already finished or cancelled.
+func (r *EventRepository) Cancel(eventId uuid.UUID, reason *string, cancelledAt time.Time) error {
+	result := r.db.Model(&model.Event{}).
+		Where("id = ? AND status IN ?", eventId, []constants.EventStatus{constants.EVENT_STATUS_IN_DECISION, constants.EVENT_STATUS_UPCOMING}).
+		Updates(map[string]any{
+			"status":        constants.EVENT_STATUS_CANCELLED,
+			"cancelled_at":  cancelledAt,
+			"cancel_reason": reason,
+			"version":       nextVersion,
+		})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("EVENT_REPOSITORY::CANCEL Failed to cancel event")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
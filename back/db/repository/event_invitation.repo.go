@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"app/db"
+	model "app/db/models"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type EventInvitationRepository struct {
+	db *gorm.DB
+}
+
+func NewEventInvitationRepository(database *gorm.DB) *EventInvitationRepository {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &EventInvitationRepository{
+		db: database,
+	}
+}
+
+// GenerateToken generates a cryptographically secure random token usable in URLs
+func (*EventInvitationRepository) GenerateToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		log.Error().Err(err).Msg("EVENT_INVITATION_REPOSITORY::GENERATE_TOKEN Failed to generate random token")
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Creates an invitation with a new random token
+func (r *EventInvitationRepository) Create(invitation *model.EventInvitation) error {
+	token, err := r.GenerateToken()
+	if err != nil {
+		return err
+	}
+
+	invitation.Id = uuid.New()
+	invitation.Token = token
+
+	if err := r.db.Create(&invitation).Error; err != nil {
+		log.Error().Err(err).Msg("EVENT_INVITATION_REPOSITORY::CREATE Failed to create invitation")
+		return err
+	}
+
+	return nil
+}
+
+// retrieves all invitations of an event, newest first
+func (r *EventInvitationRepository) FindByEventId(eventId uuid.UUID, invitations *[]model.EventInvitation) error {
+	if err := r.db.Where("event_id = ?", eventId).Order("created_at DESC").Find(&invitations).Error; err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("EVENT_INVITATION_REPOSITORY::FIND_BY_EVENT_ID Failed to get invitations by event ID")
+		return err
+	}
+
+	return nil
+}
+
+// Finds an invitation of an event by ID
+func (r *EventInvitationRepository) FindOneByIdAndEventId(id uuid.UUID, eventId uuid.UUID, invitation *model.EventInvitation) error {
+	if err := r.db.Where("id = ? AND event_id = ?", id, eventId).First(&invitation).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("EVENT_INVITATION_REPOSITORY::FIND_ONE_BY_ID_AND_EVENT_ID Failed to find invitation")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Finds an invitation by its token
+func (r *EventInvitationRepository) FindOneByToken(token string, invitation *model.EventInvitation) error {
+	if err := r.db.Where("token = ?", token).First(&invitation).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("EVENT_INVITATION_REPOSITORY::FIND_ONE_BY_TOKEN Failed to find invitation by token")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Revokes an invitation, keeping the first revocation date
+func (r *EventInvitationRepository) Revoke(invitation *model.EventInvitation) error {
+	if invitation.RevokedAt != nil {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	if err := r.db.Model(&invitation).Update("revoked_at", now).Error; err != nil {
+		log.Error().Err(err).Msg("EVENT_INVITATION_REPOSITORY::REVOKE Failed to revoke invitation")
+		return err
+	}
+
+	return nil
+}
+
+// recordInvitationUse increments the use count of a usable invitation.
+// The conditional update prevents concurrent joins from exceeding the maximum number of uses.
+func recordInvitationUse(tx *gorm.DB, invitationId uuid.UUID) error {
+	now := time.Now().UTC()
+	result := tx.Model(&model.EventInvitation{}).
+		Where("id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?) AND (max_uses IS NULL OR use_count < max_uses)", invitationId, now).
+		Updates(map[string]any{
+			"use_count":    gorm.Expr("use_count + 1"),
+			"last_used_at": now,
+		})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("EVENT_INVITATION_REPOSITORY::RECORD_USE Failed to record invitation use")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// JoinWithInvitation records a use of the invitation and creates the membership in a single transaction.
+// Returns gorm.ErrRecordNotFound when the invitation is no longer usable, constants.ERR_EVENT_FULL.Err when the event is full.
+func (r *EventInvitationRepository) JoinWithInvitation(invitationId uuid.UUID, accountEvent *model.AccountEvent) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkCapacity(tx, accountEvent.EventId); err != nil {
+			return err
+		}
+
+		if err := recordInvitationUse(tx, invitationId); err != nil {
+			return err
+		}
+
+		accountEvent.InvitationId = &invitationId
+		if err := tx.Create(&accountEvent).Error; err != nil {
+			log.Error().Err(err).Msg("EVENT_INVITATION_REPOSITORY::JOIN_WITH_INVITATION Failed to create account_event")
+			return err
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"app/db"
+	model "app/db/models"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type EventTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewEventTemplateRepository(database *gorm.DB) *EventTemplateRepository {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &EventTemplateRepository{
+		db: database,
+	}
+}
+
+// Creates a template
+func (r *EventTemplateRepository) Create(template *model.EventTemplate) error {
+	template.Id = uuid.New()
+
+	if err := r.db.Omit(clause.Associations).Create(&template).Error; err != nil {
+		log.Error().Err(err).Msg("EVENT_TEMPLATE_REPOSITORY::CREATE Failed to create template")
+		return err
+	}
+
+	return nil
+}
+
+// retrieves all templates of an account, sorted by name
+func (r *EventTemplateRepository) FindByOwnerId(ownerId uuid.UUID, templates *[]model.EventTemplate) error {
+	if err := r.db.Where("owner_id = ?", ownerId).Order("name ASC").Find(&templates).Error; err != nil {
+		log.Error().Err(err).Str("ownerId", ownerId.String()).Msg("EVENT_TEMPLATE_REPOSITORY::FIND_BY_OWNER_ID Failed to get templates by owner ID")
+		return err
+	}
+
+	return nil
+}
+
+// Finds a template of an account by ID
+func (r *EventTemplateRepository) FindOneByIdAndOwnerId(id uuid.UUID, ownerId uuid.UUID, template *model.EventTemplate) error {
+	if err := r.db.Where("id = ? AND owner_id = ?", id, ownerId).First(&template).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("EVENT_TEMPLATE_REPOSITORY::FIND_ONE_BY_ID_AND_OWNER_ID Failed to find template")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Deletes a template of an account.
+// Returns gorm.ErrRecordNotFound when the account has no such template.
+func (r *EventTemplateRepository) Delete(id uuid.UUID, ownerId uuid.UUID) error {
+	result := r.db.Where("id = ? AND owner_id = ?", id, ownerId).Delete(&model.EventTemplate{})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("EVENT_TEMPLATE_REPOSITORY::DELETE Failed to delete template")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"app/commons/constants"
+	"app/db"
+	model "app/db/models"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JoinRequestRepository struct {
+	db *gorm.DB
+}
+
+func NewJoinRequestRepository(database *gorm.DB) *JoinRequestRepository {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &JoinRequestRepository{
+		db: database,
+	}
+}
+
+// Creates a join request
+// Create creates the join request and records a use of its invitation, if any, in a single transaction.
+// Returns gorm.ErrRecordNotFound when the invitation is no longer usable.
+func (r *JoinRequestRepository) Create(joinRequest *model.JoinRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if joinRequest.InvitationId != nil {
+			if err := recordInvitationUse(tx, *joinRequest.InvitationId); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Omit(clause.Associations).Create(&joinRequest).Error; err != nil {
+			log.Error().Err(err).Msg("JOIN_REQUEST_REPOSITORY::CREATE Failed to create join request")
+			return err
+		}
+
+		return nil
+	})
+}
+
+// Finds the most recent join request of an account for an event
+func (r *JoinRequestRepository) FindLatestByAccountAndEventId(accountId, eventId uuid.UUID, joinRequest *model.JoinRequest) error {
+	if err := r.db.Where("account_id = ? AND event_id = ?", accountId, eventId).Order("created_at DESC").First(&joinRequest).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("JOIN_REQUEST_REPOSITORY::FIND_LATEST_BY_ACCOUNT_AND_EVENT_ID Failed to find join request")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// retrieves the pending join requests of an event, oldest first
+func (r *JoinRequestRepository) FindPendingByEventId(eventId uuid.UUID, joinRequests *[]model.JoinRequest) error {
+	if err := r.db.Where("event_id = ? AND status = ?", eventId, constants.JOIN_REQUEST_STATUS_PENDING).
+		Preload("Account").
+		Order("created_at ASC").
+		Find(&joinRequests).Error; err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("JOIN_REQUEST_REPOSITORY::FIND_PENDING_BY_EVENT_ID Failed to get pending join requests")
+		return err
+	}
+
+	return nil
+}
+
+// Finds a join request of an event by ID
+func (r *JoinRequestRepository) FindOneByIdAndEventId(id, eventId uuid.UUID, joinRequest *model.JoinRequest) error {
+	if err := r.db.Where("id = ? AND event_id = ?", id, eventId).Preload("Account").First(&joinRequest).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("JOIN_REQUEST_REPOSITORY::FIND_ONE_BY_ID_AND_EVENT_ID Failed to find join request")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// decideJoinRequest moves the request from its current status to the given one.
+// Returns gorm.ErrRecordNotFound when the request was decided in the meantime.
+func decideJoinRequest(tx *gorm.DB, joinRequest *model.JoinRequest, status constants.JoinRequestStatus) error {
+	now := time.Now().UTC()
+	result := tx.Model(&model.JoinRequest{}).
+		Where("id = ? AND status = ?", joinRequest.Id, joinRequest.Status).
+		Updates(map[string]any{"status": status, "decided_at": now})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("JOIN_REQUEST_REPOSITORY::DECIDE Failed to update join request")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	joinRequest.Status = status
+	joinRequest.DecidedAt = &now
+
+	return nil
+}
+
+// approveJoinRequest marks the request as approved and creates the membership
+func approveJoinRequest(tx *gorm.DB, joinRequest *model.JoinRequest) error {
+	if err := decideJoinRequest(tx, joinRequest, constants.JOIN_REQUEST_STATUS_APPROVED); err != nil {
+		return err
+	}
+
+	accountEvent := model.AccountEvent{
+		AccountId:    joinRequest.AccountId,
+		EventId:      joinRequest.EventId,
+		InvitationId: joinRequest.InvitationId,
+	}
+	if err := tx.Create(&accountEvent).Error; err != nil {
+		log.Error().Err(err).Msg("JOIN_REQUEST_REPOSITORY::APPROVE Failed to create account_event")
+		return err
+	}
+
+	return nil
+}
+
+// Approve marks the request as approved and creates the membership in a single transaction.
+// When the event is full the request is waitlisted instead, the status of the request tells which one happened.
+func (r *JoinRequestRepository) Approve(joinRequest *model.JoinRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkCapacity(tx, joinRequest.EventId); errors.Is(err, constants.ERR_EVENT_FULL.Err) {
+			return decideJoinRequest(tx, joinRequest, constants.JOIN_REQUEST_STATUS_WAITLISTED)
+		} else if err != nil {
+			return err
+		}
+
+		return approveJoinRequest(tx, joinRequest)
+	})
+}
+
+// retrieves the waitlisted requests of an event, in the order they will be promoted
+func (r *JoinRequestRepository) FindWaitlistedByEventId(eventId uuid.UUID, joinRequests *[]model.JoinRequest) error {
+	if err := r.db.Where("event_id = ? AND status = ?", eventId, constants.JOIN_REQUEST_STATUS_WAITLISTED).
+		Preload("Account").
+		Order("COALESCE(decided_at, created_at) ASC").
+		Order("id ASC").
+		Find(&joinRequests).Error; err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("JOIN_REQUEST_REPOSITORY::FIND_WAITLISTED_BY_EVENT_ID Failed to get waitlisted join requests")
+		return err
+	}
+
+	return nil
+}
+
+// PromoteFirstWaitlisted approves the request waitlisted first when the event has a free seat, in a single transaction.
+// Returns gorm.ErrRecordNotFound when nobody is waitlisted and constants.ERR_EVENT_FULL.Err when the event is still full.
+func (r *JoinRequestRepository) PromoteFirstWaitlisted(eventId uuid.UUID, joinRequest *model.JoinRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkCapacity(tx, eventId); err != nil {
+			return err
+		}
+
+		// Requests moved to the waitlist on approval wait since their approval
+		if err := tx.Where("event_id = ? AND status = ?", eventId, constants.JOIN_REQUEST_STATUS_WAITLISTED).
+			Preload("Account").
+			Order("COALESCE(decided_at, created_at) ASC").
+			Order("id ASC").
+			First(&joinRequest).Error; err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error().Err(err).Msg("JOIN_REQUEST_REPOSITORY::PROMOTE_FIRST_WAITLISTED Failed to find waitlisted join request")
+			}
+			return err
+		}
+
+		return approveJoinRequest(tx, joinRequest)
+	})
+}
+
+// DeleteWaitlisted removes the account from the waitlist of the event.
+// Returns gorm.ErrRecordNotFound when the account is not waitlisted.
+func (r *JoinRequestRepository) DeleteWaitlisted(accountId, eventId uuid.UUID) error {
+	result := r.db.Where("account_id = ? AND event_id = ? AND status = ?", accountId, eventId, constants.JOIN_REQUEST_STATUS_WAITLISTED).Delete(&model.JoinRequest{})
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("JOIN_REQUEST_REPOSITORY::DELETE_WAITLISTED Failed to delete waitlisted join request")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Reject marks the request as rejected
+func (r *JoinRequestRepository) Reject(joinRequest *model.JoinRequest) error {
+	return decideJoinRequest(r.db, joinRequest, constants.JOIN_REQUEST_STATUS_REJECTED)
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"app/db"
+	model "app/db/models"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RsvpRepository struct {
+	db *gorm.DB
+}
+
+func NewRsvpRepository(database *gorm.DB) *RsvpRepository {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &RsvpRepository{
+		db: database,
+	}
+}
+
+// Save records the answer of the account for the slot, replacing its previous answer
+func (r *RsvpRepository) Save(rsvp *model.Rsvp) error {
+	rsvp.UpdatedAt = time.Now().UTC()
+
+	if err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "slot_id"}, {Name: "account_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "updated_at"}),
+	}).Create(&rsvp).Error; err != nil {
+		log.Error().Err(err).Msg("RSVP_REPOSITORY::SAVE Failed to save rsvp")
+		return err
+	}
+
+	return nil
+}
+
+// Finds the answer of an account for a slot
+func (r *RsvpRepository) FindOneBySlotAndAccountId(slotId, accountId uuid.UUID, rsvp *model.Rsvp) error {
+	if err := r.db.Where("slot_id = ? AND account_id = ?", slotId, accountId).First(&rsvp).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Msg("RSVP_REPOSITORY::FIND_ONE_BY_SLOT_AND_ACCOUNT_ID Failed to find rsvp")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// retrieves all answers for a slot
+func (r *RsvpRepository) FindBySlotId(slotId uuid.UUID, rsvps *[]model.Rsvp) error {
+	if err := r.db.Where("slot_id = ?", slotId).Order("updated_at ASC").Find(&rsvps).Error; err != nil {
+		log.Error().Err(err).Str("slotId", slotId.String()).Msg("RSVP_REPOSITORY::FIND_BY_SLOT_ID Failed to get rsvps by slot ID")
+		return err
+	}
+
+	return nil
+}
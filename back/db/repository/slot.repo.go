@@ -1,9 +1,11 @@
 package repository
 
 import (
+	"app/commons/constants"
 	"app/db"
 	model "app/db/models"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -33,6 +35,44 @@ func (r *SlotRepository) Create(slot *model.Slot) error {
 	return nil
 }
 
+// CreateValidated creates the validated slot and moves the event to UPCOMING in a single transaction.
+// The event must still be in decision at the given version without a validated slot, so that concurrent
+// confirmations cannot both succeed. Returns gorm.ErrRecordNotFound when another change came first.
+func (r *SlotRepository) CreateValidated(slot *model.Slot, eventVersion int) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Event{}).
+			Where("id = ? AND version = ? AND status = ?", slot.EventId, eventVersion, constants.EVENT_STATUS_IN_DECISION).
+			Updates(map[string]any{
+				"status":         constants.EVENT_STATUS_UPCOMING,
+				"rescheduled_at": nil,
+				"version":        nextVersion,
+			})
+		if result.Error != nil {
+			log.Error().Err(result.Error).Str("eventId", slot.EventId.String()).Msg("SLOT_REPOSITORY::CREATE_VALIDATED Failed to update event status")
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		var validatedCount int64
+		if err := tx.Model(&model.Slot{}).Where("event_id = ? AND is_validated = ?", slot.EventId, true).Count(&validatedCount).Error; err != nil {
+			log.Error().Err(err).Str("eventId", slot.EventId.String()).Msg("SLOT_REPOSITORY::CREATE_VALIDATED Failed to count validated slots")
+			return err
+		}
+		if validatedCount > 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Create(slot).Error; err != nil {
+			log.Error().Err(err).Str("eventId", slot.EventId.String()).Msg("SLOT_REPOSITORY::CREATE_VALIDATED Failed to create slot")
+			return err
+		}
+
+		return nil
+	})
+}
+
 func (r *SlotRepository) Updates(slot *model.Slot) error {
 	if err := r.db.Omit(clause.Associations).Updates(&slot).Error; err != nil {
 		log.Error().Err(err).Msg("SLOT_REPOSITORY::UPDATES Failed to update slot")
@@ -80,11 +120,63 @@ func (r *SlotRepository) DeleteByEventId(eventId uuid.UUID) error {
 	return nil
 }
 
+// DeleteValidatedSlotByEventId removes the validated slot of the event along with the RSVPs given for it
 func (r *SlotRepository) DeleteValidatedSlotByEventId(eventId uuid.UUID) error {
-	if err := r.db.Where("event_id = ? AND is_validated = ?", eventId, true).Delete(&model.Slot{}).Error; err != nil {
-		log.Error().Err(err).Str("eventId", eventId.String()).Msg("SLOT_REPOSITORY::DELETE_VALIDATED_BY_EVENT_ID Failed to delete validated slot by event ID")
-		return err
-	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("event_id = ?", eventId).Delete(&model.Rsvp{}).Error; err != nil {
+			log.Error().Err(err).Str("eventId", eventId.String()).Msg("SLOT_REPOSITORY::DELETE_VALIDATED_BY_EVENT_ID Failed to delete rsvps by event ID")
+			return err
+		}
 
-	return nil
+		if err := tx.Where("event_id = ? AND is_validated = ?", eventId, true).Delete(&model.Slot{}).Error; err != nil {
+			log.Error().Err(err).Str("eventId", eventId.String()).Msg("SLOT_REPOSITORY::DELETE_VALIDATED_BY_EVENT_ID Failed to delete validated slot by event ID")
+			return err
+		}
+
+		return nil
+	})
+}
+
+// Reschedule drops the validated slot of an upcoming event and moves the event back to IN_DECISION in a single
+// transaction, keeping the dropped slot as the previous slot. The RSVPs given for the slot are removed, and the
+// availabilities as well when clearAvailabilities is set. The event must still be upcoming at the given version.
+// Returns gorm.ErrRecordNotFound when another change came first.
+func (r *SlotRepository) Reschedule(slot model.Slot, eventVersion int, rescheduledAt time.Time, clearAvailabilities bool) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Event{}).
+			Where("id = ? AND version = ? AND status = ?", slot.EventId, eventVersion, constants.EVENT_STATUS_UPCOMING).
+			Updates(map[string]any{
+				"status":                  constants.EVENT_STATUS_IN_DECISION,
+				"previous_slot_starts_at": slot.StartsAt,
+				"previous_slot_ends_at":   slot.EndsAt,
+				"rescheduled_at":          rescheduledAt,
+				"version":                 nextVersion,
+			})
+		if result.Error != nil {
+			log.Error().Err(result.Error).Str("eventId", slot.EventId.String()).Msg("SLOT_REPOSITORY::RESCHEDULE Failed to update event status")
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Where("event_id = ?", slot.EventId).Delete(&model.Rsvp{}).Error; err != nil {
+			log.Error().Err(err).Str("eventId", slot.EventId.String()).Msg("SLOT_REPOSITORY::RESCHEDULE Failed to delete rsvps by event ID")
+			return err
+		}
+
+		if err := tx.Where("event_id = ? AND is_validated = ?", slot.EventId, true).Delete(&model.Slot{}).Error; err != nil {
+			log.Error().Err(err).Str("eventId", slot.EventId.String()).Msg("SLOT_REPOSITORY::RESCHEDULE Failed to delete validated slot by event ID")
+			return err
+		}
+
+		if clearAvailabilities {
+			if err := tx.Where("event_id = ?", slot.EventId).Delete(&model.Availability{}).Error; err != nil {
+				log.Error().Err(err).Str("eventId", slot.EventId.String()).Msg("SLOT_REPOSITORY::RESCHEDULE Failed to delete availabilities by event ID")
+				return err
+			}
+		}
+
+		return nil
+	})
 }
@@ -0,0 +1,163 @@
+package test
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type AccountEventRepoTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo *repository.AccountEventRepository
+}
+
+func (suite *AccountEventRepoTestSuite) SetupSuite() {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+
+	suite.db = database
+
+	err = database.AutoMigrate(&model.Event{}, &model.Account{}, &model.AccountEvent{}, &model.Availability{}, &model.Rsvp{})
+	suite.Require().NoError(err)
+
+	suite.repo = repository.NewAccountEventRepository(database)
+}
+
+func (suite *AccountEventRepoTestSuite) SetupTest() {
+	suite.db.Where("1 = 1").Delete(&model.Rsvp{})
+	suite.db.Where("1 = 1").Delete(&model.Availability{})
+	suite.db.Where("1 = 1").Delete(&model.AccountEvent{})
+	suite.db.Where("1 = 1").Delete(&model.Event{})
+}
+
+func (suite *AccountEventRepoTestSuite) TearDownSuite() {
+	sqlDB, _ := suite.db.DB()
+	sqlDB.Close()
+}
+
+// Helper function to create an event with a participant who has one availability
+func (suite *AccountEventRepoTestSuite) createEvent() (model.Event, uuid.UUID) {
+	event := model.Event{Id: uuid.New(), Name: "Team meeting", OwnerId: uuid.New(), Status: constants.EVENT_STATUS_IN_DECISION}
+	suite.Require().NoError(suite.db.Omit("Owner").Create(&event).Error)
+
+	participantId := uuid.New()
+	memberships := []model.AccountEvent{
+		{AccountId: event.OwnerId, EventId: event.Id, Role: constants.EVENT_ROLE_OWNER},
+		{AccountId: participantId, EventId: event.Id, Role: constants.EVENT_ROLE_PARTICIPANT},
+	}
+	suite.Require().NoError(suite.db.Omit("Account", "Event").Create(&memberships).Error)
+
+	availability := model.Availability{
+		Id:        uuid.New(),
+		AccountId: participantId,
+		EventId:   event.Id,
+		StartsAt:  time.Now(),
+		EndsAt:    time.Now().Add(time.Hour),
+	}
+	suite.Require().NoError(suite.db.Omit("Account", "Event").Create(&availability).Error)
+
+	return event, participantId
+}
+
+func (suite *AccountEventRepoTestSuite) TestDelete_RemovesMembershipAndAvailabilities() {
+	event, participantId := suite.createEvent()
+
+	suite.Require().NoError(suite.repo.Delete(participantId, event.Id))
+
+	var memberships, availabilities int64
+	suite.db.Model(&model.AccountEvent{}).Where("event_id = ? AND account_id = ?", event.Id, participantId).Count(&memberships)
+	suite.db.Model(&model.Availability{}).Where("event_id = ? AND account_id = ?", event.Id, participantId).Count(&availabilities)
+	suite.Zero(memberships)
+	suite.Zero(availabilities)
+}
+
+func (suite *AccountEventRepoTestSuite) TestDelete_RemovesRsvps() {
+	event, participantId := suite.createEvent()
+	rsvp := model.Rsvp{SlotId: uuid.New(), AccountId: participantId, EventId: event.Id, Status: constants.RSVP_STATUS_ATTENDING}
+	suite.Require().NoError(suite.db.Create(&rsvp).Error)
+
+	suite.Require().NoError(suite.repo.Delete(participantId, event.Id))
+
+	var rsvps int64
+	suite.db.Model(&model.Rsvp{}).Where("event_id = ? AND account_id = ?", event.Id, participantId).Count(&rsvps)
+	suite.Zero(rsvps)
+}
+
+func (suite *AccountEventRepoTestSuite) TestDelete_WithdrawsOwnershipNomination() {
+	event, participantId := suite.createEvent()
+	suite.Require().NoError(suite.db.Model(&model.Event{}).Where("id = ?", event.Id).Update("pending_owner_id", participantId).Error)
+
+	suite.Require().NoError(suite.repo.Delete(participantId, event.Id))
+
+	var reloaded model.Event
+	suite.Require().NoError(suite.db.Where("id = ?", event.Id).First(&reloaded).Error)
+	suite.Nil(reloaded.PendingOwnerId)
+}
+
+func (suite *AccountEventRepoTestSuite) TestDelete_NonMember() {
+	event, _ := suite.createEvent()
+
+	err := suite.repo.Delete(uuid.New(), event.Id)
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *AccountEventRepoTestSuite) TestJoin_RespectsMaxParticipants() {
+	event, _ := suite.createEvent()
+	maxParticipants := 3
+	suite.Require().NoError(suite.db.Model(&event).Update("max_participants", maxParticipants).Error)
+
+	first := model.AccountEvent{AccountId: uuid.New(), EventId: event.Id, Role: constants.EVENT_ROLE_PARTICIPANT}
+	suite.Require().NoError(suite.repo.Join(&first))
+
+	second := model.AccountEvent{AccountId: uuid.New(), EventId: event.Id, Role: constants.EVENT_ROLE_PARTICIPANT}
+	suite.ErrorIs(suite.repo.Join(&second), constants.ERR_EVENT_FULL.Err)
+
+	var members int64
+	suite.db.Model(&model.AccountEvent{}).Where("event_id = ?", event.Id).Count(&members)
+	suite.Equal(int64(3), members)
+}
+
+func TestAccountEventRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountEventRepoTestSuite))
+}
+
+func (suite *AccountEventRepoTestSuite) TestCommentDigest_SentOncePerPeriod() {
+	event, participantId := suite.createEvent()
+	subscribedAt := time.Now().UTC().Add(-25 * time.Hour).Truncate(time.Second)
+
+	suite.Require().NoError(suite.repo.SetCommentDigest(participantId, event.Id, true, subscribedAt))
+	// Subscribing again keeps the current period
+	suite.Require().NoError(suite.repo.SetCommentDigest(participantId, event.Id, true, time.Now().UTC()))
+
+	due, err := suite.repo.FindDueCommentDigests(time.Now().UTC().Add(-24 * time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Len(due, 1)
+	suite.Equal(participantId, due[0].AccountId)
+	suite.Require().NotNil(due[0].CommentDigestSentAt)
+	suite.True(subscribedAt.Equal(*due[0].CommentDigestSentAt))
+
+	previous := *due[0].CommentDigestSentAt
+	suite.Require().NoError(suite.repo.MarkCommentDigestSent(participantId, event.Id, previous, time.Now().UTC()))
+	suite.ErrorIs(suite.repo.MarkCommentDigestSent(participantId, event.Id, previous, time.Now().UTC()), gorm.ErrRecordNotFound, "a digest is only sent once per period")
+
+	due, err = suite.repo.FindDueCommentDigests(time.Now().UTC().Add(-24 * time.Hour))
+	suite.Require().NoError(err)
+	suite.Empty(due)
+
+	suite.Require().NoError(suite.repo.SetCommentDigest(participantId, event.Id, false, time.Now().UTC()))
+	var membership model.AccountEvent
+	suite.Require().NoError(suite.db.Where("account_id = ? AND event_id = ?", participantId, event.Id).First(&membership).Error)
+	suite.False(membership.CommentDigest)
+	suite.Nil(membership.CommentDigestSentAt)
+
+	suite.ErrorIs(suite.repo.SetCommentDigest(uuid.New(), event.Id, true, time.Now().UTC()), gorm.ErrRecordNotFound)
+}
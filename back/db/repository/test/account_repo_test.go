@@ -0,0 +1,112 @@
+package test
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type AccountRepoTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo *repository.AccountRepository
+}
+
+func (suite *AccountRepoTestSuite) SetupSuite() {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+
+	suite.db = database
+
+	err = database.AutoMigrate(&model.Account{}, &model.AccountProvider{})
+	suite.Require().NoError(err)
+
+	suite.repo = repository.NewAccountRepository(database)
+}
+
+func (suite *AccountRepoTestSuite) SetupTest() {
+	suite.db.Where("1 = 1").Delete(&model.Account{})
+}
+
+func (suite *AccountRepoTestSuite) TearDownSuite() {
+	sqlDB, _ := suite.db.DB()
+	sqlDB.Close()
+}
+
+// Helper function to create a guest account
+func (suite *AccountRepoTestSuite) createGuestAccount(displayName string) model.Account {
+	var account model.Account
+	err := suite.repo.Create(repository.AccountCreateDto{
+		Id:       uuid.New(),
+		UserName: &displayName,
+		Color:    "#AABBCC",
+		Language: constants.ACCOUNT_LANGUAGE_EN,
+		TimeZone: *time.UTC,
+		IsGuest:  true,
+	}, &account)
+	suite.Require().NoError(err)
+	return account
+}
+
+func (suite *AccountRepoTestSuite) TestFindOneByUsername_IgnoresGuests() {
+	suite.createGuestAccount("guestname")
+
+	var account model.Account
+	err := suite.repo.FindOneByUsername("guestname", &account, nil)
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *AccountRepoTestSuite) TestUpgradeGuest_KeepsIdAndClearsGuestFlag() {
+	guest := suite.createGuestAccount("guestname")
+	email := "guest@example.com"
+	termsVersion := "1.0"
+
+	account := guest
+	err := suite.repo.UpgradeGuest(repository.AccountCreateDto{
+		Email:        &email,
+		Password:     "Password1!",
+		Language:     constants.ACCOUNT_LANGUAGE_FR,
+		TermsVersion: &termsVersion,
+		TimeZone:     *time.UTC,
+	}, &account)
+	suite.Require().NoError(err)
+
+	var reloaded model.Account
+	suite.Require().NoError(suite.repo.FindOneById(guest.Id, &reloaded))
+	suite.False(reloaded.IsGuest)
+	suite.Nil(reloaded.UserName)
+	suite.Equal(email, *reloaded.Email)
+	suite.Equal(constants.ACCOUNT_LANGUAGE_FR, reloaded.Language)
+	suite.NotNil(reloaded.TermsAcceptedAt)
+	suite.True(reloaded.ComparePassword("Password1!"))
+}
+
+func (suite *AccountRepoTestSuite) TestUpgradeGuest_IgnoresFullAccounts() {
+	guest := suite.createGuestAccount("guestname")
+	suite.Require().NoError(suite.db.Model(&model.Account{}).Where("id = ?", guest.Id).Update("is_guest", false).Error)
+	email := "guest@example.com"
+
+	account := guest
+	err := suite.repo.UpgradeGuest(repository.AccountCreateDto{
+		Email:    &email,
+		Password: "Password1!",
+		TimeZone: *time.UTC,
+	}, &account)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	var reloaded model.Account
+	suite.Require().NoError(suite.repo.FindOneById(guest.Id, &reloaded))
+	suite.Nil(reloaded.Email)
+}
+
+func TestAccountRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountRepoTestSuite))
+}
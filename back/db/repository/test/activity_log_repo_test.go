@@ -0,0 +1,108 @@
+package test
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type ActivityLogRepoTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo *repository.ActivityLogRepository
+}
+
+func (suite *ActivityLogRepoTestSuite) SetupSuite() {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+
+	suite.db = database
+
+	err = database.AutoMigrate(&model.Account{}, &model.ActivityLog{})
+	suite.Require().NoError(err)
+
+	suite.repo = repository.NewActivityLogRepository(database)
+}
+
+func (suite *ActivityLogRepoTestSuite) SetupTest() {
+	suite.db.Where("1 = 1").Delete(&model.ActivityLog{})
+}
+
+func (suite *ActivityLogRepoTestSuite) TearDownSuite() {
+	sqlDB, _ := suite.db.DB()
+	sqlDB.Close()
+}
+
+func (suite *ActivityLogRepoTestSuite) TestCreate_KeepsChangesAndActor() {
+	actor := model.Account{Id: uuid.New()}
+	suite.Require().NoError(suite.db.Create(&actor).Error)
+
+	activityLog := model.ActivityLog{
+		Id:        uuid.New(),
+		EventId:   uuid.New(),
+		ActorId:   &actor.Id,
+		Type:      constants.DOMAIN_EVENT_EVENT_UPDATED,
+		Before:    map[string]any{"name": "Team meeting", "maxParticipants": nil},
+		After:     map[string]any{"name": "Team lunch", "maxParticipants": 8},
+		CreatedAt: time.Now().UTC(),
+	}
+	suite.Require().NoError(suite.repo.Create(&activityLog))
+
+	var reloaded model.ActivityLog
+	suite.Require().NoError(suite.repo.FindOneById(activityLog.Id, &reloaded))
+	suite.Equal(map[string]any{"name": "Team meeting", "maxParticipants": nil}, reloaded.Before)
+	suite.Equal(map[string]any{"name": "Team lunch", "maxParticipants": float64(8)}, reloaded.After)
+	suite.Require().NotNil(reloaded.Actor)
+	suite.Equal(actor.Id, reloaded.Actor.Id)
+	suite.Nil(reloaded.Account)
+}
+
+func (suite *ActivityLogRepoTestSuite) TestFindByEventId_NewestFirstWithCursor() {
+	eventId := uuid.New()
+	start := time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC)
+	var created []uuid.UUID
+	for i := range 5 {
+		activityLog := model.ActivityLog{Id: uuid.New(), EventId: eventId, Type: constants.DOMAIN_EVENT_MEMBER_JOINED, CreatedAt: start.Add(time.Duration(i) * time.Minute)}
+		suite.Require().NoError(suite.repo.Create(&activityLog))
+		created = append([]uuid.UUID{activityLog.Id}, created...)
+	}
+	// Entries of other events are not listed
+	other := model.ActivityLog{Id: uuid.New(), EventId: uuid.New(), Type: constants.DOMAIN_EVENT_MEMBER_JOINED, CreatedAt: start}
+	suite.Require().NoError(suite.repo.Create(&other))
+
+	total, err := suite.repo.CountByEventId(eventId)
+	suite.Require().NoError(err)
+	suite.Equal(int64(5), total)
+
+	var paged []uuid.UUID
+	var after *repository.CreatedAtCursor
+	for {
+		page, err := suite.repo.FindByEventId(eventId, after, 2, 0)
+		suite.Require().NoError(err)
+		if len(page) == 0 {
+			break
+		}
+		for _, activityLog := range page {
+			paged = append(paged, activityLog.Id)
+		}
+		cursor := repository.NewCreatedAtCursor(page[len(page)-1].Id, page[len(page)-1].CreatedAt)
+		after = &cursor
+	}
+	suite.Equal(created, paged)
+
+	page, err := suite.repo.FindByEventId(eventId, nil, 2, 4)
+	suite.Require().NoError(err)
+	suite.Require().Len(page, 1)
+	suite.Equal(created[4], page[0].Id)
+}
+
+func TestActivityLogRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(ActivityLogRepoTestSuite))
+}
@@ -0,0 +1,119 @@
+package test
+
+import (
+	model "app/db/models"
+	"app/db/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type CommentRepoTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo *repository.CommentRepository
+}
+
+func (suite *CommentRepoTestSuite) SetupSuite() {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+
+	suite.db = database
+
+	err = database.AutoMigrate(&model.Account{}, &model.Comment{})
+	suite.Require().NoError(err)
+
+	suite.repo = repository.NewCommentRepository(database)
+}
+
+func (suite *CommentRepoTestSuite) SetupTest() {
+	suite.db.Where("1 = 1").Delete(&model.Comment{})
+}
+
+func (suite *CommentRepoTestSuite) TearDownSuite() {
+	sqlDB, _ := suite.db.DB()
+	sqlDB.Close()
+}
+
+func (suite *CommentRepoTestSuite) createComment(eventId uuid.UUID, authorId uuid.UUID, body string, createdAt time.Time) model.Comment {
+	comment := model.Comment{Id: uuid.New(), EventId: eventId, AuthorId: authorId, Body: body, CreatedAt: createdAt}
+	suite.Require().NoError(suite.repo.Create(&comment))
+	return comment
+}
+
+func (suite *CommentRepoTestSuite) TestDelete_KeepsTombstone() {
+	author := model.Account{Id: uuid.New()}
+	suite.Require().NoError(suite.db.Create(&author).Error)
+	eventId := uuid.New()
+	comment := suite.createComment(eventId, author.Id, "See you there", time.Now().UTC())
+
+	suite.Require().NoError(suite.repo.UpdateBody(comment.Id, "See you there @bob", time.Now().UTC()))
+
+	moderatorId := uuid.New()
+	suite.Require().NoError(suite.repo.Delete(comment.Id, moderatorId, time.Now().UTC()))
+	suite.ErrorIs(suite.repo.Delete(comment.Id, moderatorId, time.Now().UTC()), gorm.ErrRecordNotFound)
+	suite.ErrorIs(suite.repo.UpdateBody(comment.Id, "Edited after deletion", time.Now().UTC()), gorm.ErrRecordNotFound)
+
+	var reloaded model.Comment
+	suite.Require().NoError(suite.repo.FindOneById(comment.Id, eventId, &reloaded))
+	suite.Empty(reloaded.Body)
+	suite.NotNil(reloaded.EditedAt)
+	suite.NotNil(reloaded.DeletedAt)
+	suite.Equal(&moderatorId, reloaded.DeletedById)
+	suite.Equal(author.Id, reloaded.Author.Id)
+
+	suite.ErrorIs(suite.repo.FindOneById(comment.Id, uuid.New(), &reloaded), gorm.ErrRecordNotFound, "a comment is only found in its event")
+}
+
+func (suite *CommentRepoTestSuite) TestFindByEventId_NewestFirstWithCursor() {
+	eventId := uuid.New()
+	now := time.Now().UTC()
+	var comments []model.Comment
+	for i := range 3 {
+		comments = append(comments, suite.createComment(eventId, uuid.New(), "Comment", now.Add(time.Duration(i)*time.Minute)))
+	}
+	suite.createComment(uuid.New(), uuid.New(), "Other event", now)
+
+	total, err := suite.repo.CountByEventId(eventId)
+	suite.Require().NoError(err)
+	suite.Equal(int64(3), total)
+
+	page, err := suite.repo.FindByEventId(eventId, nil, 2, 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(page, 2)
+	suite.Equal(comments[2].Id, page[0].Id)
+	suite.Equal(comments[1].Id, page[1].Id)
+
+	cursor := repository.NewCreatedAtCursor(page[1].Id, page[1].CreatedAt)
+	page, err = suite.repo.FindByEventId(eventId, &cursor, 2, 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(page, 1)
+	suite.Equal(comments[0].Id, page[0].Id)
+}
+
+func (suite *CommentRepoTestSuite) TestFindForDigest_OtherAuthorsInPeriod() {
+	eventId := uuid.New()
+	readerId := uuid.New()
+	since := time.Now().UTC().Add(-24 * time.Hour)
+	until := time.Now().UTC()
+
+	suite.createComment(eventId, uuid.New(), "Before the period", since.Add(-time.Minute))
+	expected := suite.createComment(eventId, uuid.New(), "In the period", since.Add(time.Hour))
+	suite.createComment(eventId, readerId, "Written by the reader", since.Add(time.Hour))
+	deleted := suite.createComment(eventId, uuid.New(), "Deleted", since.Add(time.Hour))
+	suite.Require().NoError(suite.repo.Delete(deleted.Id, deleted.AuthorId, until))
+	suite.createComment(eventId, uuid.New(), "After the period", until)
+
+	comments, err := suite.repo.FindForDigest(eventId, readerId, since, until)
+	suite.Require().NoError(err)
+	suite.Require().Len(comments, 1)
+	suite.Equal(expected.Id, comments[0].Id)
+}
+
+func TestCommentRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(CommentRepoTestSuite))
+}
@@ -0,0 +1,124 @@
+package test
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type EventInvitationRepoTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo *repository.EventInvitationRepository
+}
+
+func (suite *EventInvitationRepoTestSuite) SetupSuite() {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+
+	suite.db = database
+
+	err = database.AutoMigrate(&model.Event{}, &model.Account{}, &model.AccountEvent{}, &model.EventInvitation{})
+	suite.Require().NoError(err)
+
+	suite.repo = repository.NewEventInvitationRepository(database)
+}
+
+func (suite *EventInvitationRepoTestSuite) SetupTest() {
+	suite.db.Where("1 = 1").Delete(&model.AccountEvent{})
+	suite.db.Where("1 = 1").Delete(&model.EventInvitation{})
+	suite.db.Where("1 = 1").Delete(&model.Event{})
+}
+
+func (suite *EventInvitationRepoTestSuite) TearDownSuite() {
+	sqlDB, _ := suite.db.DB()
+	sqlDB.Close()
+}
+
+// Helper function to create an invitation
+func (suite *EventInvitationRepoTestSuite) createInvitation(maxUses *int, expiresAt *time.Time) model.EventInvitation {
+	event := model.Event{Id: uuid.New(), Name: "Team meeting", OwnerId: uuid.New(), Status: constants.EVENT_STATUS_IN_DECISION}
+	suite.Require().NoError(suite.db.Omit("Owner").Create(&event).Error)
+
+	invitation := model.EventInvitation{
+		EventId:     event.Id,
+		CreatedById: uuid.New(),
+		MaxUses:     maxUses,
+		ExpiresAt:   expiresAt,
+	}
+	suite.Require().NoError(suite.repo.Create(&invitation))
+	return invitation
+}
+
+func (suite *EventInvitationRepoTestSuite) countMembers(eventId uuid.UUID) int64 {
+	var count int64
+	suite.db.Model(&model.AccountEvent{}).Where("event_id = ?", eventId).Count(&count)
+	return count
+}
+
+func (suite *EventInvitationRepoTestSuite) TestCreate_GeneratesUniqueTokens() {
+	first := suite.createInvitation(nil, nil)
+	second := suite.createInvitation(nil, nil)
+
+	suite.NotEmpty(first.Token)
+	suite.NotEqual(first.Token, second.Token)
+
+	var found model.EventInvitation
+	suite.Require().NoError(suite.repo.FindOneByToken(first.Token, &found))
+	suite.Equal(first.Id, found.Id)
+}
+
+func (suite *EventInvitationRepoTestSuite) TestJoinWithInvitation_TracksUsage() {
+	invitation := suite.createInvitation(nil, nil)
+	accountEvent := model.AccountEvent{AccountId: uuid.New(), EventId: invitation.EventId}
+
+	suite.Require().NoError(suite.repo.JoinWithInvitation(invitation.Id, &accountEvent))
+
+	var reloaded model.EventInvitation
+	suite.Require().NoError(suite.repo.FindOneByToken(invitation.Token, &reloaded))
+	suite.Equal(1, reloaded.UseCount)
+	suite.NotNil(reloaded.LastUsedAt)
+	suite.Equal(&invitation.Id, accountEvent.InvitationId)
+	suite.Equal(int64(1), suite.countMembers(invitation.EventId))
+}
+
+func (suite *EventInvitationRepoTestSuite) TestJoinWithInvitation_StopsAtMaxUses() {
+	one := 1
+	invitation := suite.createInvitation(&one, nil)
+
+	first := model.AccountEvent{AccountId: uuid.New(), EventId: invitation.EventId}
+	suite.Require().NoError(suite.repo.JoinWithInvitation(invitation.Id, &first))
+
+	second := model.AccountEvent{AccountId: uuid.New(), EventId: invitation.EventId}
+	err := suite.repo.JoinWithInvitation(invitation.Id, &second)
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Equal(int64(1), suite.countMembers(invitation.EventId))
+}
+
+func (suite *EventInvitationRepoTestSuite) TestJoinWithInvitation_RejectsRevokedAndExpired() {
+	revoked := suite.createInvitation(nil, nil)
+	suite.Require().NoError(suite.repo.Revoke(&revoked))
+
+	past := time.Now().UTC().Add(-time.Minute)
+	expired := suite.createInvitation(nil, &past)
+
+	for _, invitation := range []model.EventInvitation{revoked, expired} {
+		accountEvent := model.AccountEvent{AccountId: uuid.New(), EventId: invitation.EventId}
+		err := suite.repo.JoinWithInvitation(invitation.Id, &accountEvent)
+
+		suite.ErrorIs(err, gorm.ErrRecordNotFound)
+		suite.Equal(int64(0), suite.countMembers(invitation.EventId))
+	}
+}
+
+func TestEventInvitationRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(EventInvitationRepoTestSuite))
+}
@@ -0,0 +1,377 @@
+package test
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type EventRepoTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo *repository.EventRepository
+}
+
+func (suite *EventRepoTestSuite) SetupSuite() {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+
+	suite.db = database
+
+	err = database.AutoMigrate(&model.Event{}, &model.Account{}, &model.AccountEvent{}, &model.Slot{}, &model.Availability{})
+	suite.Require().NoError(err)
+
+	suite.repo = repository.NewEventRepository(database)
+}
+
+func (suite *EventRepoTestSuite) SetupTest() {
+	suite.db.Where("1 = 1").Delete(&model.Slot{})
+	suite.db.Where("1 = 1").Delete(&model.AccountEvent{})
+	suite.db.Where("1 = 1").Delete(&model.Event{})
+}
+
+func (suite *EventRepoTestSuite) TearDownSuite() {
+	sqlDB, _ := suite.db.DB()
+	sqlDB.Close()
+}
+
+// Helper function to create an event with its owner and a participant
+func (suite *EventRepoTestSuite) createEvent() (model.Event, uuid.UUID) {
+	event := model.Event{Id: uuid.New(), Name: "Team meeting", OwnerId: uuid.New(), Status: constants.EVENT_STATUS_IN_DECISION}
+	suite.Require().NoError(suite.db.Omit("Owner").Create(&event).Error)
+
+	participantId := uuid.New()
+	memberships := []model.AccountEvent{
+		{AccountId: event.OwnerId, EventId: event.Id, Role: constants.EVENT_ROLE_OWNER},
+		{AccountId: participantId, EventId: event.Id, Role: constants.EVENT_ROLE_PARTICIPANT},
+	}
+	suite.Require().NoError(suite.db.Omit("Account", "Event").Create(&memberships).Error)
+
+	return event, participantId
+}
+
+func (suite *EventRepoTestSuite) roleOf(eventId, accountId uuid.UUID) constants.EventRole {
+	var accountEvent model.AccountEvent
+	suite.Require().NoError(suite.db.Where("event_id = ? AND account_id = ?", eventId, accountId).First(&accountEvent).Error)
+	return accountEvent.Role
+}
+
+func (suite *EventRepoTestSuite) TestTransferOwnership_SwapsRoles() {
+	event, participantId := suite.createEvent()
+	suite.Require().NoError(suite.repo.UpdateColumns(event.Id, map[string]any{"pending_owner_id": participantId}))
+
+	suite.Require().NoError(suite.repo.TransferOwnership(event.Id, event.OwnerId, participantId))
+
+	var reloaded model.Event
+	suite.Require().NoError(suite.db.Where("id = ?", event.Id).First(&reloaded).Error)
+	suite.Equal(participantId, reloaded.OwnerId)
+	suite.Nil(reloaded.PendingOwnerId)
+	suite.Equal(constants.EVENT_ROLE_OWNER, suite.roleOf(event.Id, participantId))
+	suite.Equal(constants.EVENT_ROLE_ORGANISER, suite.roleOf(event.Id, event.OwnerId))
+}
+
+func (suite *EventRepoTestSuite) TestTransferOwnership_StaleOwner() {
+	event, participantId := suite.createEvent()
+
+	err := suite.repo.TransferOwnership(event.Id, uuid.New(), participantId)
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Equal(constants.EVENT_ROLE_PARTICIPANT, suite.roleOf(event.Id, participantId))
+}
+
+func (suite *EventRepoTestSuite) TestTransferOwnership_NonMemberIsRolledBack() {
+	event, _ := suite.createEvent()
+
+	err := suite.repo.TransferOwnership(event.Id, event.OwnerId, uuid.New())
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	var reloaded model.Event
+	suite.Require().NoError(suite.db.Where("id = ?", event.Id).First(&reloaded).Error)
+	suite.Equal(event.OwnerId, reloaded.OwnerId)
+}
+
+func (suite *EventRepoTestSuite) TestCancel_SetsStatusAndReason() {
+	event, _ := suite.createEvent()
+	reason := "Venue unavailable"
+
+	suite.Require().NoError(suite.repo.Cancel(event.Id, &reason, time.Now()))
+
+	var reloaded model.Event
+	suite.Require().NoError(suite.db.Where("id = ?", event.Id).First(&reloaded).Error)
+	suite.Equal(constants.EVENT_STATUS_CANCELLED, reloaded.Status)
+	suite.NotNil(reloaded.CancelledAt)
+	suite.Require().NotNil(reloaded.CancelReason)
+	suite.Equal(reason, *reloaded.CancelReason)
+}
+
+func (suite *EventRepoTestSuite) TestCancel_AlreadyCancelled() {
+	event, _ := suite.createEvent()
+	suite.Require().NoError(suite.repo.Cancel(event.Id, nil, time.Now()))
+
+	err := suite.repo.Cancel(event.Id, nil, time.Now())
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *EventRepoTestSuite) TestFindEventsPastDeadline_HandledOnce() {
+	event, _ := suite.createEvent()
+	now := time.Now().UTC()
+	suite.Require().NoError(suite.repo.UpdateColumns(event.Id, map[string]any{"decision_deadline": now.Add(-time.Minute)}))
+
+	events, err := suite.repo.FindEventsPastDeadline(now)
+	suite.Require().NoError(err)
+	suite.Require().Len(events, 1)
+	suite.Equal(event.Id, events[0].Id)
+
+	suite.Require().NoError(suite.repo.MarkDeadlineProcessed(event.Id, now))
+	suite.ErrorIs(suite.repo.MarkDeadlineProcessed(event.Id, now), gorm.ErrRecordNotFound)
+
+	events, err = suite.repo.FindEventsPastDeadline(now)
+	suite.Require().NoError(err)
+	suite.Empty(events)
+}
+
+func (suite *EventRepoTestSuite) TestFindEventsToRemind_OnlyDeadlinesWithinLimit() {
+	soon, _ := suite.createEvent()
+	later, _ := suite.createEvent()
+	now := time.Now().UTC()
+	suite.Require().NoError(suite.repo.UpdateColumns(soon.Id, map[string]any{"decision_deadline": now.Add(2 * time.Hour)}))
+	suite.Require().NoError(suite.repo.UpdateColumns(later.Id, map[string]any{"decision_deadline": now.Add(72 * time.Hour)}))
+
+	events, err := suite.repo.FindEventsToRemind(now, now.Add(24*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Len(events, 1)
+	suite.Equal(soon.Id, events[0].Id)
+
+	suite.Require().NoError(suite.repo.MarkDeadlineReminded(soon.Id, now))
+	events, err = suite.repo.FindEventsToRemind(now, now.Add(24*time.Hour))
+	suite.Require().NoError(err)
+	suite.Empty(events)
+}
+
+// Helper function to create an event owned by ownerId with the given name and status
+func (suite *EventRepoTestSuite) createListedEvent(ownerId uuid.UUID, name string, status constants.EventStatus) model.Event {
+	// The creation date is given so that SQLite stores it in the format of the query parameters
+	event := model.Event{Id: uuid.New(), Name: name, OwnerId: ownerId, Status: status, CreatedAt: time.Now().UTC()}
+	suite.Require().NoError(suite.db.Omit("Owner").Create(&event).Error)
+	suite.Require().NoError(suite.db.Omit("Account", "Event").Create(&model.AccountEvent{AccountId: ownerId, EventId: event.Id, Role: constants.EVENT_ROLE_OWNER}).Error)
+	return event
+}
+
+func (suite *EventRepoTestSuite) TestFindEventsByAccountId_Filters() {
+	accountId := uuid.New()
+	owned := suite.createListedEvent(accountId, "Sprint review", constants.EVENT_STATUS_IN_DECISION)
+	suite.createListedEvent(accountId, "Board games night", constants.EVENT_STATUS_FINISHED)
+	joined := suite.createListedEvent(uuid.New(), "Sprint planning", constants.EVENT_STATUS_UPCOMING)
+	suite.Require().NoError(suite.db.Omit("Account", "Event").Create(&model.AccountEvent{AccountId: accountId, EventId: joined.Id, Role: constants.EVENT_ROLE_PARTICIPANT}).Error)
+
+	filter := repository.EventListFilter{Search: "sprint", Ownership: "owned"}
+	events, err := suite.repo.FindEventsByAccountId(accountId, filter, 20, 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(events, 1)
+	suite.Equal(owned.Id, events[0].Id)
+	total, err := suite.repo.CountEventsByAccountId(accountId, filter)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), total)
+
+	filter = repository.EventListFilter{Statuses: []constants.EventStatus{constants.EVENT_STATUS_UPCOMING}}
+	events, err = suite.repo.FindEventsByAccountId(accountId, filter, 20, 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(events, 1)
+	suite.Equal(joined.Id, events[0].Id)
+	total, err = suite.repo.CountEventsByAccountId(accountId, filter)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), total)
+
+	counts, err := suite.repo.CountEventsByStatus(accountId, repository.EventListFilter{Search: "sprint", Statuses: []constants.EventStatus{constants.EVENT_STATUS_UPCOMING}})
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), counts[constants.EVENT_STATUS_IN_DECISION])
+	suite.Equal(int64(1), counts[constants.EVENT_STATUS_UPCOMING])
+	suite.Equal(int64(0), counts[constants.EVENT_STATUS_FINISHED])
+	suite.Equal(int64(0), counts[constants.EVENT_STATUS_CANCELLED])
+}
+
+func (suite *EventRepoTestSuite) TestFindEventsByAccountId_SortByNextSlot() {
+	accountId := uuid.New()
+	withoutSlot := suite.createListedEvent(accountId, "Aaa without slot", constants.EVENT_STATUS_IN_DECISION)
+	later := suite.createListedEvent(accountId, "Bbb later", constants.EVENT_STATUS_UPCOMING)
+	sooner := suite.createListedEvent(accountId, "Ccc sooner", constants.EVENT_STATUS_UPCOMING)
+	now := time.Now().UTC()
+	slots := []model.Slot{
+		{Id: uuid.New(), EventId: later.Id, StartsAt: now.Add(48 * time.Hour), EndsAt: now.Add(49 * time.Hour), IsValidated: true},
+		{Id: uuid.New(), EventId: sooner.Id, StartsAt: now.Add(24 * time.Hour), EndsAt: now.Add(25 * time.Hour), IsValidated: true},
+	}
+	suite.Require().NoError(suite.db.Omit("Event").Create(&slots).Error)
+
+	events, err := suite.repo.FindEventsByAccountId(accountId, repository.EventListFilter{Sort: "next_slot"}, 20, 0)
+	suite.Require().NoError(err)
+	suite.Equal([]uuid.UUID{sooner.Id, later.Id, withoutSlot.Id}, ids(events))
+
+	events, err = suite.repo.FindEventsByAccountId(accountId, repository.EventListFilter{Sort: "next_slot", Desc: true}, 20, 0)
+	suite.Require().NoError(err)
+	suite.Equal([]uuid.UUID{later.Id, sooner.Id, withoutSlot.Id}, ids(events))
+
+	events, err = suite.repo.FindEventsByAccountId(accountId, repository.EventListFilter{Sort: "name", Desc: true}, 2, 0)
+	suite.Require().NoError(err)
+	suite.Equal([]uuid.UUID{sooner.Id, later.Id}, ids(events))
+}
+
+func (suite *EventRepoTestSuite) TestFindEventsByAccountId_Cursor() {
+	accountId := uuid.New()
+	var expected []uuid.UUID
+	for _, name := range []string{"Event A", "Event B", "Event C", "Event D", "Event E"} {
+		expected = append(expected, suite.createListedEvent(accountId, name, constants.EVENT_STATUS_IN_DECISION).Id)
+	}
+	// Same name as the previous event, the id decides
+	expected = append(expected, suite.createListedEvent(accountId, "Event E", constants.EVENT_STATUS_IN_DECISION).Id)
+	suite.createListedEvent(accountId, "Event F", constants.EVENT_STATUS_UPCOMING)
+
+	for _, sort := range []string{"status", "name", "next_slot", "created_at"} {
+		filter := repository.EventListFilter{Sort: sort, Statuses: []constants.EventStatus{constants.EVENT_STATUS_IN_DECISION}}
+		all, err := suite.repo.FindEventsByAccountId(accountId, filter, 20, 0)
+		suite.Require().NoError(err)
+		if sort == "status" || sort == "name" {
+			// Ties on the name are ordered by id
+			if expected[4].String() > expected[5].String() {
+				expected[4], expected[5] = expected[5], expected[4]
+			}
+			suite.Equal(expected, ids(all), sort)
+		}
+
+		var paged []model.Event
+		for {
+			page, err := suite.repo.FindEventsByAccountId(accountId, filter, 2, 0)
+			suite.Require().NoError(err)
+			paged = append(paged, page...)
+			if len(page) < 2 {
+				break
+			}
+			after := repository.NewEventListCursor(page[len(page)-1])
+			filter.After = &after
+		}
+		suite.Equal(ids(all), ids(paged), sort)
+	}
+}
+
+func (suite *EventRepoTestSuite) version(eventId uuid.UUID) int {
+	var event model.Event
+	suite.Require().NoError(suite.db.Where("id = ?", eventId).First(&event).Error)
+	return event.Version
+}
+
+func (suite *EventRepoTestSuite) TestUpdates_IncrementVersion() {
+	event, _ := suite.createEvent()
+	suite.Equal(1, suite.version(event.Id))
+
+	event.Name = "Renamed meeting"
+	suite.Require().NoError(suite.repo.Updates(&event))
+	suite.Equal(2, event.Version, "the reloaded event has the new version")
+
+	suite.Require().NoError(suite.repo.UpdateColumns(event.Id, map[string]any{"invite_only": true}))
+	suite.Equal(3, suite.version(event.Id))
+
+	suite.Require().NoError(suite.repo.Cancel(event.Id, nil, time.Now()))
+	suite.Equal(4, suite.version(event.Id))
+}
+
+func (suite *EventRepoTestSuite) TestUpdateColumnsAtVersion_SingleWinner() {
+	event, _ := suite.createEvent()
+	version := 1
+
+	suite.Require().NoError(suite.repo.UpdateColumnsAtVersion(&event, map[string]any{"name": "Renamed meeting", "invite_only": true}, &version))
+	suite.Equal(2, event.Version, "a single update increments the version once")
+	suite.Equal("Renamed meeting", event.Name)
+	suite.True(event.InviteOnly)
+
+	stale := event
+	suite.ErrorIs(suite.repo.UpdateColumnsAtVersion(&stale, map[string]any{"name": "Stale meeting"}, &version), gorm.ErrRecordNotFound, "the version was already claimed")
+	suite.Equal(2, suite.version(event.Id))
+
+	suite.Require().NoError(suite.repo.UpdateColumnsAtVersion(&event, map[string]any{"invite_only": false}, nil))
+	suite.Equal(3, suite.version(event.Id))
+	suite.False(event.InviteOnly)
+}
+
+func (suite *EventRepoTestSuite) TestCreateValidated_ConcurrentConfirmations() {
+	event, _ := suite.createEvent()
+	slotRepo := repository.NewSlotRepository(suite.db)
+	start := time.Now().Add(24 * time.Hour).UTC()
+	newSlot := func() *model.Slot {
+		return &model.Slot{Id: uuid.New(), EventId: event.Id, StartsAt: start, EndsAt: start.Add(time.Hour), IsValidated: true}
+	}
+
+	// Both confirmations read the event at version 1
+	suite.Require().NoError(slotRepo.CreateValidated(newSlot(), 1))
+	suite.ErrorIs(slotRepo.CreateValidated(newSlot(), 1), gorm.ErrRecordNotFound)
+
+	var reloaded model.Event
+	suite.Require().NoError(suite.db.Where("id = ?", event.Id).First(&reloaded).Error)
+	suite.Equal(constants.EVENT_STATUS_UPCOMING, reloaded.Status)
+	suite.Equal(2, reloaded.Version)
+
+	var validatedCount int64
+	suite.db.Model(&model.Slot{}).Where("event_id = ? AND is_validated = ?", event.Id, true).Count(&validatedCount)
+	suite.Equal(int64(1), validatedCount)
+
+	// An up to date version does not help once the event is confirmed
+	suite.ErrorIs(slotRepo.CreateValidated(newSlot(), 2), gorm.ErrRecordNotFound)
+}
+
+func (suite *EventRepoTestSuite) TestFindEventsToFinish() {
+	now := time.Now().UTC()
+	create := func(status constants.EventStatus, endsAt time.Time) model.Event {
+		event := model.Event{Id: uuid.New(), Name: "Team meeting", OwnerId: uuid.New(), Status: status, StartsAt: now.Add(-48 * time.Hour), EndsAt: endsAt}
+		suite.Require().NoError(suite.db.Omit("Owner").Create(&event).Error)
+		return event
+	}
+
+	ended := create(constants.EVENT_STATUS_IN_DECISION, now.Add(-time.Hour))
+	open := create(constants.EVENT_STATUS_IN_DECISION, now.Add(time.Hour))
+	create(constants.EVENT_STATUS_CANCELLED, now.Add(-time.Hour))
+	create(constants.EVENT_STATUS_FINISHED, now.Add(-time.Hour))
+
+	// The validated slot ends before the period of the event
+	slotEnded := create(constants.EVENT_STATUS_UPCOMING, now.Add(24*time.Hour))
+	upcoming := create(constants.EVENT_STATUS_UPCOMING, now.Add(24*time.Hour))
+	suite.Require().NoError(suite.db.Create(&[]model.Slot{
+		{Id: uuid.New(), EventId: slotEnded.Id, StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour), IsValidated: true},
+		{Id: uuid.New(), EventId: upcoming.Id, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour), IsValidated: true},
+		{Id: uuid.New(), EventId: open.Id, StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour)},
+	}).Error)
+
+	events, err := suite.repo.FindEventsToFinish(now)
+	suite.Require().NoError(err)
+	suite.ElementsMatch([]uuid.UUID{ended.Id, slotEnded.Id}, ids(events))
+}
+
+func (suite *EventRepoTestSuite) TestTransitionStatus_Once() {
+	event, _ := suite.createEvent()
+	open := []constants.EventStatus{constants.EVENT_STATUS_IN_DECISION, constants.EVENT_STATUS_UPCOMING}
+
+	from, err := suite.repo.TransitionStatus(event.Id, open, constants.EVENT_STATUS_FINISHED)
+	suite.Require().NoError(err)
+	suite.Equal(constants.EVENT_STATUS_IN_DECISION, from)
+	suite.Equal(2, suite.version(event.Id))
+
+	_, err = suite.repo.TransitionStatus(event.Id, open, constants.EVENT_STATUS_FINISHED)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound, "the event already finished")
+}
+
+func ids(events []model.Event) []uuid.UUID {
+	result := make([]uuid.UUID, 0, len(events))
+	for _, event := range events {
+		result = append(result, event.Id)
+	}
+	return result
+}
+
+func TestEventRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(EventRepoTestSuite))
+}
@@ -0,0 +1,201 @@
+package test
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type JoinRequestRepoTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo *repository.JoinRequestRepository
+}
+
+func (suite *JoinRequestRepoTestSuite) SetupSuite() {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+
+	suite.db = database
+
+	err = database.AutoMigrate(&model.Event{}, &model.Account{}, &model.AccountEvent{}, &model.JoinRequest{}, &model.EventInvitation{})
+	suite.Require().NoError(err)
+
+	suite.repo = repository.NewJoinRequestRepository(database)
+}
+
+func (suite *JoinRequestRepoTestSuite) SetupTest() {
+	suite.db.Where("1 = 1").Delete(&model.AccountEvent{})
+	suite.db.Where("1 = 1").Delete(&model.JoinRequest{})
+	suite.db.Where("1 = 1").Delete(&model.EventInvitation{})
+	suite.db.Where("1 = 1").Delete(&model.Event{})
+}
+
+func (suite *JoinRequestRepoTestSuite) TearDownSuite() {
+	sqlDB, _ := suite.db.DB()
+	sqlDB.Close()
+}
+
+// Helper function to create an event accepting at most maxParticipants members
+func (suite *JoinRequestRepoTestSuite) createEvent(maxParticipants *int) uuid.UUID {
+	event := model.Event{Id: uuid.New(), Name: "Team meeting", OwnerId: uuid.New(), Status: constants.EVENT_STATUS_IN_DECISION, MaxParticipants: maxParticipants}
+	suite.Require().NoError(suite.db.Omit("Owner").Create(&event).Error)
+	return event.Id
+}
+
+// Helper function to create a pending join request
+func (suite *JoinRequestRepoTestSuite) createJoinRequest(eventId uuid.UUID, createdAt time.Time) model.JoinRequest {
+	joinRequest := model.JoinRequest{
+		Id:        uuid.New(),
+		EventId:   eventId,
+		AccountId: uuid.New(),
+		Status:    constants.JOIN_REQUEST_STATUS_PENDING,
+		CreatedAt: createdAt,
+	}
+	suite.Require().NoError(suite.repo.Create(&joinRequest))
+	return joinRequest
+}
+
+func (suite *JoinRequestRepoTestSuite) countMembers(eventId uuid.UUID) int64 {
+	var count int64
+	suite.db.Model(&model.AccountEvent{}).Where("event_id = ?", eventId).Count(&count)
+	return count
+}
+
+func (suite *JoinRequestRepoTestSuite) TestCreate_RecordsInvitationUse() {
+	eventId := suite.createEvent(nil)
+	maxUses := 1
+	invitation := model.EventInvitation{Id: uuid.New(), EventId: eventId, CreatedById: uuid.New(), Token: "token", MaxUses: &maxUses}
+	suite.Require().NoError(suite.db.Omit("Event").Create(&invitation).Error)
+
+	first := model.JoinRequest{Id: uuid.New(), EventId: eventId, AccountId: uuid.New(), InvitationId: &invitation.Id, Status: constants.JOIN_REQUEST_STATUS_PENDING}
+	suite.Require().NoError(suite.repo.Create(&first))
+
+	second := model.JoinRequest{Id: uuid.New(), EventId: eventId, AccountId: uuid.New(), InvitationId: &invitation.Id, Status: constants.JOIN_REQUEST_STATUS_PENDING}
+	suite.ErrorIs(suite.repo.Create(&second), gorm.ErrRecordNotFound, "the only use of the invitation was taken")
+
+	var count int64
+	suite.db.Model(&model.JoinRequest{}).Where("event_id = ?", eventId).Count(&count)
+	suite.Equal(int64(1), count)
+	suite.Require().NoError(suite.db.First(&invitation, "id = ?", invitation.Id).Error)
+	suite.Equal(1, invitation.UseCount)
+}
+
+func (suite *JoinRequestRepoTestSuite) TestApprove_CreatesMembership() {
+	joinRequest := suite.createJoinRequest(suite.createEvent(nil), time.Now().UTC())
+
+	suite.Require().NoError(suite.repo.Approve(&joinRequest))
+
+	suite.Equal(constants.JOIN_REQUEST_STATUS_APPROVED, joinRequest.Status)
+	suite.NotNil(joinRequest.DecidedAt)
+	suite.Equal(int64(1), suite.countMembers(joinRequest.EventId))
+}
+
+func (suite *JoinRequestRepoTestSuite) TestDecide_OnlyOnce() {
+	joinRequest := suite.createJoinRequest(uuid.New(), time.Now().UTC())
+	suite.Require().NoError(suite.repo.Reject(&joinRequest))
+
+	stale := joinRequest
+	stale.Status = constants.JOIN_REQUEST_STATUS_PENDING
+	err := suite.repo.Approve(&stale)
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Equal(int64(0), suite.countMembers(joinRequest.EventId))
+}
+
+func (suite *JoinRequestRepoTestSuite) TestApprove_WaitlistsWhenFull() {
+	maxParticipants := 2
+	eventId := suite.createEvent(&maxParticipants)
+	now := time.Now().UTC()
+	first := suite.createJoinRequest(eventId, now.Add(-time.Hour))
+	second := suite.createJoinRequest(eventId, now.Add(-time.Hour))
+	third := suite.createJoinRequest(eventId, now)
+
+	suite.Require().NoError(suite.repo.Approve(&first))
+	suite.Require().NoError(suite.repo.Approve(&second))
+	suite.Require().NoError(suite.repo.Approve(&third))
+
+	suite.Equal(constants.JOIN_REQUEST_STATUS_APPROVED, second.Status)
+	suite.Equal(constants.JOIN_REQUEST_STATUS_WAITLISTED, third.Status)
+	suite.Equal(int64(2), suite.countMembers(eventId))
+}
+
+func (suite *JoinRequestRepoTestSuite) TestPromoteFirstWaitlisted() {
+	maxParticipants := 1
+	eventId := suite.createEvent(&maxParticipants)
+	now := time.Now().UTC()
+	member := suite.createJoinRequest(eventId, now.Add(-2*time.Hour))
+	suite.Require().NoError(suite.repo.Approve(&member))
+
+	waitlisted := suite.createJoinRequest(eventId, now.Add(-time.Hour))
+	suite.Require().NoError(suite.repo.Approve(&waitlisted))
+	later := suite.createJoinRequest(eventId, now)
+	suite.Require().NoError(suite.repo.Approve(&later))
+
+	var promoted model.JoinRequest
+	suite.ErrorIs(suite.repo.PromoteFirstWaitlisted(eventId, &promoted), constants.ERR_EVENT_FULL.Err)
+
+	suite.db.Where("event_id = ? AND account_id = ?", eventId, member.AccountId).Delete(&model.AccountEvent{})
+	suite.Require().NoError(suite.repo.PromoteFirstWaitlisted(eventId, &promoted))
+	suite.Equal(waitlisted.Id, promoted.Id)
+	suite.Equal(constants.JOIN_REQUEST_STATUS_APPROVED, promoted.Status)
+	suite.Equal(int64(1), suite.countMembers(eventId))
+
+	var remaining []model.JoinRequest
+	suite.Require().NoError(suite.repo.FindWaitlistedByEventId(eventId, &remaining))
+	suite.Require().Len(remaining, 1)
+	suite.Equal(later.Id, remaining[0].Id)
+}
+
+func (suite *JoinRequestRepoTestSuite) TestPromoteFirstWaitlisted_NobodyWaiting() {
+	eventId := suite.createEvent(nil)
+
+	var promoted model.JoinRequest
+	suite.ErrorIs(suite.repo.PromoteFirstWaitlisted(eventId, &promoted), gorm.ErrRecordNotFound)
+}
+
+func (suite *JoinRequestRepoTestSuite) TestFindPendingByEventId_OldestFirst() {
+	eventId := uuid.New()
+	now := time.Now().UTC()
+	newer := suite.createJoinRequest(eventId, now)
+	older := suite.createJoinRequest(eventId, now.Add(-time.Hour))
+	rejected := suite.createJoinRequest(eventId, now.Add(-2*time.Hour))
+	suite.Require().NoError(suite.repo.Reject(&rejected))
+
+	var pending []model.JoinRequest
+	suite.Require().NoError(suite.repo.FindPendingByEventId(eventId, &pending))
+
+	suite.Require().Len(pending, 2)
+	suite.Equal(older.Id, pending[0].Id)
+	suite.Equal(newer.Id, pending[1].Id)
+}
+
+func (suite *JoinRequestRepoTestSuite) TestFindLatestByAccountAndEventId() {
+	eventId := uuid.New()
+	first := suite.createJoinRequest(eventId, time.Now().UTC().Add(-time.Hour))
+	suite.Require().NoError(suite.repo.Reject(&first))
+
+	second := model.JoinRequest{
+		Id:        uuid.New(),
+		EventId:   eventId,
+		AccountId: first.AccountId,
+		Status:    constants.JOIN_REQUEST_STATUS_PENDING,
+		CreatedAt: time.Now().UTC(),
+	}
+	suite.Require().NoError(suite.repo.Create(&second))
+
+	var latest model.JoinRequest
+	suite.Require().NoError(suite.repo.FindLatestByAccountAndEventId(first.AccountId, eventId, &latest))
+	suite.Equal(second.Id, latest.Id)
+}
+
+func TestJoinRequestRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(JoinRequestRepoTestSuite))
+}
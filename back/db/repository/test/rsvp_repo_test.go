@@ -0,0 +1,69 @@
+package test
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type RsvpRepoTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo *repository.RsvpRepository
+}
+
+func (suite *RsvpRepoTestSuite) SetupSuite() {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+
+	suite.db = database
+
+	err = database.AutoMigrate(&model.Rsvp{})
+	suite.Require().NoError(err)
+
+	suite.repo = repository.NewRsvpRepository(database)
+}
+
+func (suite *RsvpRepoTestSuite) SetupTest() {
+	suite.db.Where("1 = 1").Delete(&model.Rsvp{})
+}
+
+func (suite *RsvpRepoTestSuite) TearDownSuite() {
+	sqlDB, _ := suite.db.DB()
+	sqlDB.Close()
+}
+
+func (suite *RsvpRepoTestSuite) TestSave_ReplacesPreviousAnswer() {
+	slotId, accountId, eventId := uuid.New(), uuid.New(), uuid.New()
+
+	first := model.Rsvp{SlotId: slotId, AccountId: accountId, EventId: eventId, Status: constants.RSVP_STATUS_TENTATIVE}
+	suite.Require().NoError(suite.repo.Save(&first))
+	second := model.Rsvp{SlotId: slotId, AccountId: accountId, EventId: eventId, Status: constants.RSVP_STATUS_ATTENDING}
+	suite.Require().NoError(suite.repo.Save(&second))
+
+	var rsvps []model.Rsvp
+	suite.Require().NoError(suite.repo.FindBySlotId(slotId, &rsvps))
+	suite.Require().Len(rsvps, 1)
+	suite.Equal(constants.RSVP_STATUS_ATTENDING, rsvps[0].Status)
+
+	var rsvp model.Rsvp
+	suite.Require().NoError(suite.repo.FindOneBySlotAndAccountId(slotId, accountId, &rsvp))
+	suite.Equal(constants.RSVP_STATUS_ATTENDING, rsvp.Status)
+}
+
+func (suite *RsvpRepoTestSuite) TestFindOneBySlotAndAccountId_NoAnswer() {
+	var rsvp model.Rsvp
+	err := suite.repo.FindOneBySlotAndAccountId(uuid.New(), uuid.New(), &rsvp)
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestRsvpRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(RsvpRepoTestSuite))
+}
@@ -0,0 +1,106 @@
+package test
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SlotRepoTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo *repository.SlotRepository
+}
+
+func (suite *SlotRepoTestSuite) SetupSuite() {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+
+	suite.db = database
+
+	err = database.AutoMigrate(&model.Event{}, &model.Slot{}, &model.Rsvp{}, &model.Availability{})
+	suite.Require().NoError(err)
+
+	suite.repo = repository.NewSlotRepository(database)
+}
+
+func (suite *SlotRepoTestSuite) SetupTest() {
+	suite.db.Where("1 = 1").Delete(&model.Availability{})
+	suite.db.Where("1 = 1").Delete(&model.Rsvp{})
+	suite.db.Where("1 = 1").Delete(&model.Slot{})
+	suite.db.Where("1 = 1").Delete(&model.Event{})
+}
+
+func (suite *SlotRepoTestSuite) TearDownSuite() {
+	sqlDB, _ := suite.db.DB()
+	sqlDB.Close()
+}
+
+func (suite *SlotRepoTestSuite) createConfirmedEvent() (model.Event, model.Slot) {
+	startsAt := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	event := model.Event{Id: uuid.New(), Name: "Team meeting", OwnerId: uuid.New(), Status: constants.EVENT_STATUS_UPCOMING, StartsAt: startsAt, EndsAt: startsAt.Add(72 * time.Hour)}
+	suite.Require().NoError(suite.db.Omit("Owner").Create(&event).Error)
+	slot := model.Slot{Id: uuid.New(), EventId: event.Id, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour), IsValidated: true}
+	suite.Require().NoError(suite.db.Omit("Event").Create(&slot).Error)
+	suite.Require().NoError(suite.db.Create(&model.Rsvp{SlotId: slot.Id, AccountId: uuid.New(), EventId: event.Id, Status: constants.RSVP_STATUS_ATTENDING}).Error)
+	suite.Require().NoError(suite.db.Omit("Event", "Account").Create(&model.Availability{Id: uuid.New(), EventId: event.Id, AccountId: uuid.New(), StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}).Error)
+
+	suite.Require().NoError(suite.db.First(&event, "id = ?", event.Id).Error)
+	return event, slot
+}
+
+func (suite *SlotRepoTestSuite) count(value any, eventId uuid.UUID) int64 {
+	var count int64
+	suite.Require().NoError(suite.db.Model(value).Where("event_id = ?", eventId).Count(&count).Error)
+	return count
+}
+
+func (suite *SlotRepoTestSuite) TestReschedule_DropsTheSlotAndMovesTheEventBack() {
+	event, slot := suite.createConfirmedEvent()
+	rescheduledAt := time.Date(2025, 3, 9, 12, 0, 0, 0, time.UTC)
+
+	suite.Require().NoError(suite.repo.Reschedule(slot, event.Version, rescheduledAt, true))
+
+	var rescheduled model.Event
+	suite.Require().NoError(suite.db.First(&rescheduled, "id = ?", event.Id).Error)
+	suite.Equal(constants.EVENT_STATUS_IN_DECISION, rescheduled.Status)
+	suite.Equal(event.Version+1, rescheduled.Version)
+	suite.Require().NotNil(rescheduled.GetPreviousSlot())
+	suite.True(slot.StartsAt.Equal(rescheduled.GetPreviousSlot().StartsAt))
+	suite.Zero(suite.count(&model.Slot{}, event.Id))
+	suite.Zero(suite.count(&model.Rsvp{}, event.Id))
+	suite.Zero(suite.count(&model.Availability{}, event.Id))
+}
+
+func (suite *SlotRepoTestSuite) TestReschedule_KeepsAvailabilitiesUnlessAsked() {
+	event, slot := suite.createConfirmedEvent()
+
+	suite.Require().NoError(suite.repo.Reschedule(slot, event.Version, time.Now(), false))
+
+	suite.Equal(int64(1), suite.count(&model.Availability{}, event.Id))
+}
+
+func (suite *SlotRepoTestSuite) TestReschedule_FailsWhenTheEventChanged() {
+	event, slot := suite.createConfirmedEvent()
+
+	err := suite.repo.Reschedule(slot, event.Version-1, time.Now(), true)
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	var unchanged model.Event
+	suite.Require().NoError(suite.db.First(&unchanged, "id = ?", event.Id).Error)
+	suite.Equal(constants.EVENT_STATUS_UPCOMING, unchanged.Status)
+	suite.Equal(int64(1), suite.count(&model.Slot{}, event.Id))
+	suite.Equal(int64(1), suite.count(&model.Rsvp{}, event.Id))
+	suite.Equal(int64(1), suite.count(&model.Availability{}, event.Id))
+}
+
+func TestSlotRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(SlotRepoTestSuite))
+}
@@ -19,7 +19,7 @@ const docTemplate = `{
     "paths": {
         "/api/v1/account": {
             "post": {
-                "description": "Create a new account with the provided parameters.",
+                "description": "Create a new account with the provided parameters. When a guest token cookie is present, the guest account is upgraded and keeps its events and availabilities.",
                 "consumes": [
                     "application/json"
                 ],
@@ -274,6 +274,60 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/v1/admin/events/{eventId}/ownership": {
+            "post": {
+                "description": "Admin only. Hands the event over to one of its members when the owner's account has been deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Transfer the ownership of an orphaned event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-Admin-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transfer parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ownership.OwnershipAdminTransferDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ownership.OwnershipResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_ADMIN_ACCESS_DENIED, ERR_EVENT_NOT_FOUND, ERR_EVENT_PARTICIPANT_NOT_FOUND, ERR_OWNERSHIP_NOMINEE_INVALID, or ERR_OWNERSHIP_OWNER_ACCOUNT_ACTIVE",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/auth/logout": {
             "post": {
                 "consumes": [
@@ -469,7 +523,7 @@ const docTemplate = `{
                         "description": "OK"
                     },
                     "400": {
-                        "description": "Bad Request - Code can be: ERR_AVAILABILITY_NOT_FOUND, ERR_AVAILABILITY_ACCESS_DENIED, ERR_EVENT_ACCESS_DENIED, or ERR_EVENT_ENDED",
+                        "description": "Bad Request - Code can be: ERR_AVAILABILITY_NOT_FOUND, ERR_AVAILABILITY_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, or ERR_EVENT_CANCELLED",
                         "schema": {
                             "$ref": "#/definitions/helpers.ApiError"
                         }
@@ -518,7 +572,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Code can be: ERR_AVAILABILITY_NOT_FOUND, ERR_AVAILABILITY_ACCESS_DENIED, ERR_EVENT_NOT_FOUND, ERR_EVENT_ENDED, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_START_AFTER_END, ERR_AVAILABILITY_DURATION_TOO_SHORT, ERR_AVAILABILITY_INVALID_TIME_INTERVAL, ERR_AVAILABILITY_START_BEFORE_EVENT, or ERR_AVAILABILITY_END_AFTER_EVENT",
+                        "description": "Bad Request - Code can be: ERR_AVAILABILITY_NOT_FOUND, ERR_AVAILABILITY_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, ERR_EVENT_NOT_FOUND, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_START_AFTER_END, ERR_AVAILABILITY_DURATION_TOO_SHORT, ERR_AVAILABILITY_INVALID_TIME_INTERVAL, ERR_AVAILABILITY_START_BEFORE_EVENT, or ERR_AVAILABILITY_END_AFTER_EVENT",
                         "schema": {
                             "$ref": "#/definitions/helpers.ApiError"
                         }
@@ -531,8 +585,9 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v1/events": {
+        "/api/v1/event-templates": {
             "get": {
+                "description": "Lists the templates of the user, sorted by name.",
                 "consumes": [
                     "application/json"
                 ],
@@ -540,30 +595,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Event"
-                ],
-                "summary": "Get user events",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    }
+                    "Event template"
                 ],
+                "summary": "List event templates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/lib.Pagination-event_EventListItemDto"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/eventtemplate.EventTemplateResponseDto"
+                            }
                         }
                     },
                     "400": {
@@ -580,6 +622,7 @@ const docTemplate = `{
                 ]
             },
             "post": {
+                "description": "Saves the description, duration, search period length and settings of an event the user can manage as a named template.",
                 "consumes": [
                     "application/json"
                 ],
@@ -587,17 +630,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Event"
+                    "Event template"
                 ],
-                "summary": "Create an event",
+                "summary": "Create an event template",
                 "parameters": [
                     {
-                        "description": "Event parameters",
+                        "description": "Template parameters",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/event.EventCreateDto"
+                            "$ref": "#/definitions/eventtemplate.EventTemplateCreateDto"
                         }
                     }
                 ],
@@ -605,11 +648,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/event.EventCreateResponseDto"
+                            "$ref": "#/definitions/eventtemplate.EventTemplateResponseDto"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Code can be: ERR_EVENT_START_AFTER_END, ERR_EVENT_START_BEFORE_TODAY, or ERR_EVENT_DURATION_TOO_SHORT",
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_EVENT_ACCESS_DENIED",
                         "schema": {
                             "$ref": "#/definitions/helpers.ApiError"
                         }
@@ -622,8 +665,8 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v1/events/{eventId}": {
-            "get": {
+        "/api/v1/event-templates/{templateId}": {
+            "delete": {
                 "consumes": [
                     "application/json"
                 ],
@@ -631,27 +674,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Event"
+                    "Event template"
                 ],
-                "summary": "Get event",
+                "summary": "Delete an event template",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event Id",
-                        "name": "eventId",
+                        "description": "Template Id",
+                        "name": "templateId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/event.EventFullResponseDto"
-                        }
+                        "description": "OK"
                     },
                     "400": {
-                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND",
+                        "description": "Bad Request - Code can be: ERR_EVENT_TEMPLATE_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/helpers.ApiError"
                         }
@@ -662,8 +702,11 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ]
-            },
-            "patch": {
+            }
+        },
+        "/api/v1/event-templates/{templateId}/events": {
+            "post": {
+                "description": "Creates a new event owned by the user with the settings of the template, starting at the given date.",
                 "consumes": [
                     "application/json"
                 ],
@@ -671,14 +714,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Event"
+                    "Event template"
                 ],
-                "summary": "Update an event",
+                "summary": "Create an event from a template",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event Id",
-                        "name": "eventId",
+                        "description": "Template Id",
+                        "name": "templateId",
                         "in": "path",
                         "required": true
                     },
@@ -688,16 +731,19 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/event.EventUpdateDto"
+                            "$ref": "#/definitions/eventtemplate.EventTemplateUseDto"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventCreateResponseDto"
+                        }
                     },
                     "400": {
-                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_START_AFTER_END, ERR_EVENT_DURATION_TOO_SHORT, ERR_EVENT_START_BEFORE_TODAY, or ERR_VALIDATED_SLOT_CANNOT_BE_MODIFIED",
+                        "description": "Bad Request - Code can be: ERR_EVENT_TEMPLATE_NOT_FOUND, ERR_EVENT_START_BEFORE_TODAY, ERR_EVENT_START_AFTER_END, ERR_EVENT_DURATION_TOO_SHORT, or ERR_EVENT_INVALID_MEETING_URL",
                         "schema": {
                             "$ref": "#/definitions/helpers.ApiError"
                         }
@@ -710,8 +756,9 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v1/events/{eventId}/availability": {
-            "post": {
+        "/api/v1/events": {
+            "get": {
+                "description": "Lists the events the user is a member of. The status counts ignore the status filter so that they can be shown next to it.\nIn cursor mode the total is not returned, and the next page is requested with the nextCursor of the response until it is missing.",
                 "consumes": [
                     "application/json"
                 ],
@@ -719,24 +766,138 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Availability"
+                    "Event"
                 ],
-                "summary": "Create an availability",
+                "summary": "Get user events",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Event ID",
-                        "name": "eventId",
-                        "in": "path",
-                        "required": true
+                        "description": "Cursor mode: empty for the first page, then the nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     },
                     {
-                        "description": "Availability parameters",
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "IN_DECISION",
+                                "UPCOMING",
+                                "FINISHED",
+                                "CANCELLED"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Statuses to keep",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "owned",
+                            "joined"
+                        ],
+                        "type": "string",
+                        "description": "Owned or joined events",
+                        "name": "ownership",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Keeps the events ending after this date (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Keeps the events starting before this date (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Full-text search on the name and the description",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "status",
+                            "next_slot",
+                            "created_at",
+                            "name"
+                        ],
+                        "type": "string",
+                        "default": "status",
+                        "description": "Sort option",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Sort direction",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_INVALID_PAGINATION_PARAMS, ERR_INVALID_PAGINATION_CURSOR, or ERR_EVENT_INVALID_LIST_FILTER",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Create an event",
+                "parameters": [
+                    {
+                        "description": "Event parameters",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/availability.AvailabilityCreateDto"
+                            "$ref": "#/definitions/event.EventCreateDto"
                         }
                     }
                 ],
@@ -744,11 +905,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/availability.AvailabilityResponseDto"
+                            "$ref": "#/definitions/event.EventCreateResponseDto"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ENDED, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_START_AFTER_END, ERR_AVAILABILITY_DURATION_TOO_SHORT, ERR_AVAILABILITY_INVALID_TIME_INTERVAL, ERR_AVAILABILITY_START_BEFORE_EVENT, or ERR_AVAILABILITY_END_AFTER_EVENT",
+                        "description": "Bad Request - Code can be: ERR_EVENT_START_AFTER_END, ERR_EVENT_START_BEFORE_TODAY, ERR_EVENT_DURATION_TOO_SHORT, ERR_EVENT_INVALID_MEETING_URL, or ERR_EVENT_INVALID_DECISION_DEADLINE",
                         "schema": {
                             "$ref": "#/definitions/helpers.ApiError"
                         }
@@ -761,8 +922,8 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v1/events/{eventId}/join": {
-            "post": {
+        "/api/v1/events/{eventId}": {
+            "get": {
                 "consumes": [
                     "application/json"
                 ],
@@ -772,7 +933,7 @@ const docTemplate = `{
                 "tags": [
                     "Event"
                 ],
-                "summary": "Join event",
+                "summary": "Get event",
                 "parameters": [
                     {
                         "type": "string",
@@ -787,10 +948,16 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/event.EventFullResponseDto"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the event, to send in If-Match when updating it"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ALREADY_JOINED, or ERR_EVENT_ENDED",
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_JOIN_REQUEST_PENDING",
                         "schema": {
                             "$ref": "#/definitions/helpers.ApiError"
                         }
@@ -801,10 +968,9 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ]
-            }
-        },
-        "/api/v1/events/{eventId}/profile": {
+            },
             "patch": {
+                "description": "Send the version of the event in If-Match to only update it when nobody changed it in the meantime.",
                 "consumes": [
                     "application/json"
                 ],
@@ -814,7 +980,7 @@ const docTemplate = `{
                 "tags": [
                     "Event"
                 ],
-                "summary": "Update event profile",
+                "summary": "Update an event",
                 "parameters": [
                     {
                         "type": "string",
@@ -824,13 +990,19 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Event profile parameters",
+                        "description": "Event parameters",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/event.EventProfileDto"
+                            "$ref": "#/definitions/event.EventUpdateDto"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Version of the event, as returned in the ETag header",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -838,7 +1010,13 @@ const docTemplate = `{
                         "description": "OK"
                     },
                     "400": {
-                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_INVALID_COLOR_FORMAT",
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_CANCELLED, ERR_EVENT_START_AFTER_END, ERR_EVENT_DURATION_TOO_SHORT, ERR_EVENT_START_BEFORE_TODAY, ERR_EVENT_INVALID_MEETING_URL, ERR_EVENT_INVALID_DECISION_DEADLINE, or ERR_VALIDATED_SLOT_CANNOT_BE_MODIFIED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed - Code can be: ERR_EVENT_VERSION_MISMATCH",
                         "schema": {
                             "$ref": "#/definitions/helpers.ApiError"
                         }
@@ -851,8 +1029,9 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v1/events/{eventId}/summary": {
+        "/api/v1/events/{eventId}/activity": {
             "get": {
+                "description": "Lists the joins, leaves, availability changes, slot confirmations and removals, status changes and edits of the event, the newest first, with the values of the changed fields before and after each change.\nNew entries are also sent on the SSE connection of the event as activity frames.\nIn cursor mode the total is not returned, and the next page is requested with the nextCursor of the response until it is missing.",
                 "consumes": [
                     "application/json"
                 ],
@@ -860,9 +1039,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Event"
+                    "Activity"
                 ],
-                "summary": "Get event summary",
+                "summary": "Get the activity log of an event",
                 "parameters": [
                     {
                         "type": "string",
@@ -870,26 +1049,51 @@ const docTemplate = `{
                         "name": "eventId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor mode: empty for the first page, then the nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/event.EventBasicResponseDto"
+                            "$ref": "#/definitions/activity.ActivityListResponseDto"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND",
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_INVALID_PAGINATION_PARAMS, or ERR_INVALID_PAGINATION_CURSOR",
                         "schema": {
                             "$ref": "#/definitions/helpers.ApiError"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/api/v1/slots/{slotId}": {
-            "delete": {
+        "/api/v1/events/{eventId}/availability": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -897,24 +1101,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Slot"
+                    "Availability"
                 ],
-                "summary": "Remove a validated slot",
+                "summary": "Create an availability",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Slot Id",
-                        "name": "slotId",
+                        "description": "Event ID",
+                        "name": "eventId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Availability parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/availability.AvailabilityCreateDto"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/availability.AvailabilityResponseDto"
+                        }
                     },
                     "400": {
-                        "description": "Bad Request - Code can be: ERR_SLOT_NOT_FOUND or ERR_EVENT_ACCESS_DENIED",
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_EVENT_ACCESS_DENIED, ERR_AVAILABILITY_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, ERR_EVENT_START_AFTER_END, ERR_AVAILABILITY_DURATION_TOO_SHORT, ERR_AVAILABILITY_INVALID_TIME_INTERVAL, ERR_AVAILABILITY_START_BEFORE_EVENT, or ERR_AVAILABILITY_END_AFTER_EVENT",
                         "schema": {
                             "$ref": "#/definitions/helpers.ApiError"
                         }
@@ -927,7 +1143,7 @@ const docTemplate = `{
                 ]
             }
         },
-        "/api/v1/slots/{slotId}/confirm": {
+        "/api/v1/events/{eventId}/availability/copy": {
             "post": {
                 "consumes": [
                     "application/json"
@@ -936,24 +1152,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Slot"
+                    "Availability"
                 ],
-                "summary": "Confirm a slot",
+                "summary": "Copy availabilities from another event",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Slot Id",
-                        "name": "slotId",
+                        "description": "Event ID",
+                        "name": "eventId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Confirm Slot parameters",
+                        "description": "Copy parameters",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/slot.ConfirmSlotDto"
+                            "$ref": "#/definitions/availability.AvailabilityCopyDto"
                         }
                     }
                 ],
@@ -961,11 +1177,14 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/slot.SlotResponseDto"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/availability.AvailabilityResponseDto"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Bad Request - Code can be: ERR_SLOT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, ERR_SLOT_INVALID_STARTS_AT, or ERR_SLOT_INVALID_ENDS_AT",
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_EVENT_ACCESS_DENIED, or ERR_AVAILABILITY_COPY_SAME_EVENT",
                         "schema": {
                             "$ref": "#/definitions/helpers.ApiError"
                         }
@@ -978,13 +1197,20 @@ const docTemplate = `{
                 ]
             }
         },
-        "/v1/events/{eventId}/sse": {
+        "/api/v1/events/{eventId}/availability/export": {
             "get": {
-                "description": "Establishes a Server-Sent Events connection to receive real-time updates for a specific event",
+                "description": "Returns one bucket per interval of the availability grid over the part of the event covered by availabilities or slots, telling which participants are available for the whole bucket and whether it belongs to a computed slot or to the validated slot. This part cannot exceed 31 days.\nWith format=csv, the matrix is downloaded as a CSV file with one row per bucket, one 1/0 column per participant, the number of available participants and the slot of the bucket (\"computed\" or \"validated\").",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
                 "tags": [
-                    "SSE"
+                    "Availability"
                 ],
-                "summary": "Connect to SSE for event updates",
+                "summary": "Export the availability matrix of an event",
                 "parameters": [
                     {
                         "type": "string",
@@ -992,592 +1218,3063 @@ const docTemplate = `{
                         "name": "eventId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Stream of slot update arrays",
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/sse.SSESlotUpdateMessage"
-                            }
+                            "$ref": "#/definitions/availability.AvailabilityMatrixDto"
                         }
                     },
                     "400": {
-                        "description": "Invalid event ID",
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_AVAILABILITY_INVALID_EXPORT_FORMAT or ERR_AVAILABILITY_EXPORT_TOO_LARGE",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/helpers.ApiError"
                         }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/cancel": {
+            "post": {
+                "description": "Owner only. Every other member receives a cancellation email, and the event becomes read-only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Cancel an event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
                     },
-                    "401": {
-                        "description": "User not authenticated",
+                    {
+                        "description": "Cancellation parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/event.EventCancelDto"
                         }
                     }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "account.AccountCreateDto": {
-            "type": "object",
-            "required": [
-                "email",
-                "language",
-                "password",
-                "termsAccepted",
-                "termsVersion",
-                "timeZone"
-            ],
-            "properties": {
-                "email": {
-                    "type": "string",
-                    "maxLength": 320,
-                    "minLength": 6
-                },
-                "language": {
-                    "enum": [
-                        "en",
-                        "fr"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/constants.AccountLanguage"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, or ERR_EVENT_CANCELLED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
                         }
-                    ]
-                },
-                "password": {
-                    "type": "string",
-                    "maxLength": 100
-                },
-                "termsAccepted": {
-                    "type": "boolean"
-                },
-                "termsVersion": {
-                    "type": "string"
+                    }
                 },
-                "timeZone": {
-                    "type": "string"
-                }
-            }
-        },
-        "account.AccountProviderDto": {
-            "type": "object",
-            "properties": {
-                "provider": {
-                    "$ref": "#/definitions/constants.Provider"
-                }
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "account.AccountResponseDto": {
-            "type": "object",
-            "properties": {
-                "avatarUrl": {
-                    "type": "string"
-                },
-                "color": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string"
-                },
-                "language": {
-                    "$ref": "#/definitions/constants.AccountLanguage"
-                },
-                "providers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/account.AccountProviderDto"
+        "/api/v1/events/{eventId}/clone": {
+            "post": {
+                "description": "Creates a new event with the description, duration and settings of the source event, shifted to the given start date. Copied participants receive an invitation email.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Clone an event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Clone parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.EventCloneDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventCreateResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_START_BEFORE_TODAY, ERR_EVENT_START_AFTER_END, or ERR_EVENT_DURATION_TOO_SHORT",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
                     }
                 },
-                "timeZone": {
-                    "type": "string"
-                },
-                "userName": {
-                    "type": "string"
-                }
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "account.AccountUpdateDto": {
-            "type": "object",
-            "properties": {
-                "color": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string",
-                    "maxLength": 320,
-                    "minLength": 6
-                },
-                "language": {
-                    "enum": [
-                        "en",
-                        "fr"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/constants.AccountLanguage"
-                        }
-                    ]
-                },
-                "password": {
-                    "type": "string",
-                    "maxLength": 100
-                },
+        "/api/v1/events/{eventId}/comments": {
+            "get": {
+                "description": "Lists the comments of the event, the newest first. Deleted comments are kept without their body.\nComments created, edited and deleted are also sent on the SSE connection of the event as comment frames.\nIn cursor mode the total is not returned, and the next page is requested with the nextCursor of the response until it is missing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Get the discussion thread of an event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor mode: empty for the first page, then the nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/comment.CommentListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_INVALID_PAGINATION_PARAMS, or ERR_INVALID_PAGINATION_CURSOR",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Members are mentioned with @ followed by their username: they receive the comment on their SSE connection as a comment-mention frame and by email.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Post a comment on an event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/comment.CommentCreateDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/comment.CommentResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_COMMENT_EMPTY",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/comments/digest": {
+            "put": {
+                "description": "The subscribed members receive once a day an email with the comments posted by the other members since the previous digest.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Subscribe to the comment digest of an event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Digest parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/comment.CommentDigestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/comments/{commentId}": {
+            "delete": {
+                "description": "The author can delete their comments, and the owner of the event can delete any comment to moderate the thread. The comment stays in the thread without its body.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Delete a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment Id",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_COMMENT_NOT_FOUND, or ERR_COMMENT_ACCESS_DENIED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "patch": {
+                "description": "Only the author can edit a comment. Only the members mentioned for the first time are notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Edit a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment Id",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/comment.CommentUpdateDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/comment.CommentResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_COMMENT_NOT_FOUND, ERR_COMMENT_ACCESS_DENIED, or ERR_COMMENT_EMPTY",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/guest": {
+            "post": {
+                "description": "Join an event without an account, using only a display name. The guest is identified by the guest_token cookie.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Join event as guest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Guest parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.EventGuestJoinDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventFullResponseDto"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/joinrequest.JoinRequestResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ALREADY_JOINED, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_ALREADY_AUTHENTICATED, ERR_INVITATION_REQUIRED, ERR_INVITATION_NOT_FOUND, ERR_INVITATION_EXPIRED, ERR_INVITATION_REVOKED, ERR_INVITATION_MAX_USES_REACHED, ERR_JOIN_REQUEST_PENDING, ERR_JOIN_REQUEST_REJECTED, or ERR_JOIN_REQUEST_WAITLISTED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{eventId}/invitations": {
+            "get": {
+                "description": "Owner and organisers only. Lists the invitation links of the event with their usage, newest first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invitation"
+                ],
+                "summary": "List invitation links",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/invitation.InvitationResponseDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_EVENT_ACCESS_DENIED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Owner and organisers only. Creates an invitation link with an optional expiry date and maximum number of uses.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invitation"
+                ],
+                "summary": "Create an invitation link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Invitation parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/invitation.InvitationCreateDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/invitation.InvitationResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, or ERR_INVITATION_INVALID_EXPIRATION",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/invitations/{invitationId}": {
+            "delete": {
+                "description": "Owner and organisers only. The link can no longer be used to join the event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invitation"
+                ],
+                "summary": "Revoke an invitation link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invitation Id",
+                        "name": "invitationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, or ERR_INVITATION_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/join": {
+            "post": {
+                "description": "Invite-only events require the token of a valid invitation link. Events requiring approval answer 202 with the join request sent to the owner, full events answer 202 with a WAITLISTED request.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Join event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invitation token",
+                        "name": "invitation",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventFullResponseDto"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/joinrequest.JoinRequestResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ALREADY_JOINED, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_INVITATION_REQUIRED, ERR_INVITATION_NOT_FOUND, ERR_INVITATION_EXPIRED, ERR_INVITATION_REVOKED, ERR_INVITATION_MAX_USES_REACHED, ERR_JOIN_REQUEST_PENDING, ERR_JOIN_REQUEST_REJECTED, or ERR_JOIN_REQUEST_WAITLISTED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/join-requests": {
+            "get": {
+                "description": "Owner and organisers only. Lists the join requests waiting for approval, oldest first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "JoinRequest"
+                ],
+                "summary": "List pending join requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/joinrequest.JoinRequestResponseDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_EVENT_ACCESS_DENIED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/join-requests/{requestId}/approve": {
+            "post": {
+                "description": "Owner and organisers only. The requester becomes a participant of the event, or is waitlisted with the WAITLISTED status when the event is full.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "JoinRequest"
+                ],
+                "summary": "Approve a join request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Join request Id",
+                        "name": "requestId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/joinrequest.JoinRequestResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_JOIN_REQUEST_NOT_FOUND, or ERR_JOIN_REQUEST_ALREADY_DECIDED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/join-requests/{requestId}/reject": {
+            "post": {
+                "description": "Owner and organisers only. The requester cannot ask to join the event again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "JoinRequest"
+                ],
+                "summary": "Reject a join request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Join request Id",
+                        "name": "requestId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/joinrequest.JoinRequestResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_JOIN_REQUEST_NOT_FOUND, or ERR_JOIN_REQUEST_ALREADY_DECIDED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/leave": {
+            "post": {
+                "description": "Removes the current user and their availabilities from the event, the first waitlisted user takes the seat. Waitlisted users leave the waitlist. The owner has to transfer the ownership before leaving.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Leave an event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_EVENT_OWNER_CANNOT_LEAVE",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/ownership/accept": {
+            "post": {
+                "description": "Nominee only. The nominee becomes the owner and the previous owner stays as organiser, both are emailed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ownership"
+                ],
+                "summary": "Accept an ownership nomination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ownership.OwnershipResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_PARTICIPANT_NOT_FOUND, ERR_OWNERSHIP_NOMINEE_INVALID, or ERR_OWNERSHIP_NOMINATION_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/ownership/nomination": {
+            "post": {
+                "description": "Owner only. The nominee becomes the owner once they accept, a new nomination replaces the previous one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ownership"
+                ],
+                "summary": "Nominate a new owner",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Nomination parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ownership.OwnershipNominateDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ownership.OwnershipResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, or ERR_OWNERSHIP_NOMINEE_INVALID",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "The owner withdraws the nomination, or the nominee declines it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ownership"
+                ],
+                "summary": "Cancel or decline an ownership nomination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ownership.OwnershipResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, or ERR_OWNERSHIP_NOMINATION_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/participants/{participantId}": {
+            "delete": {
+                "description": "Owner only. Removes the participant and their availabilities from the event.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Remove a participant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Participant account Id",
+                        "name": "participantId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, or ERR_EVENT_OWNER_CANNOT_LEAVE",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/participants/{participantId}/role": {
+            "patch": {
+                "description": "Owner only. Organisers can edit the event and confirm slots, viewers can follow the event without submitting availabilities.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Update participant role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Participant account Id",
+                        "name": "participantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.EventRoleUpdateDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventParticipantDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, or ERR_EVENT_OWNER_ROLE_LOCKED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/profile": {
+            "patch": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Update event profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Event profile parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.EventProfileDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_INVALID_COLOR_FORMAT",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/rsvp": {
+            "put": {
+                "description": "Members tell whether they will attend the validated slot of an upcoming event. A new answer replaces the previous one and the owner receives the new counts over SSE.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RSVP"
+                ],
+                "summary": "Answer for the validated slot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Answer",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rsvp.RsvpAnswerDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rsvp.RsvpResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_RSVP_CLOSED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/rsvps": {
+            "get": {
+                "description": "Requires the MANAGE permission. Returns the answers of the members and the number of members per answer.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RSVP"
+                ],
+                "summary": "List the answers for the validated slot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rsvp.RsvpSummaryDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, or ERR_SLOT_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/events/{eventId}/summary": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Get event summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventBasicResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/{eventId}/waitlist": {
+            "get": {
+                "description": "Owner and organisers only. Lists the users waiting for a seat, in the order they will become participants.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "JoinRequest"
+                ],
+                "summary": "List the waitlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Id",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/joinrequest.JoinRequestResponseDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_EVENT_ACCESS_DENIED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/rsvp": {
+            "get": {
+                "description": "No authentication required, the signed token of the confirmation email identifies the member. Returns the validated slot and the current answer of the member.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RSVP"
+                ],
+                "summary": "Get the slot of an RSVP link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RSVP token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rsvp.RsvpTokenResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_RSVP_TOKEN_INVALID",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "No authentication required, the signed token of the confirmation email identifies the member. A new answer replaces the previous one and the owner receives the new counts over SSE.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RSVP"
+                ],
+                "summary": "Answer through an RSVP link",
+                "parameters": [
+                    {
+                        "description": "Token and answer",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rsvp.RsvpTokenAnswerDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rsvp.RsvpResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_RSVP_TOKEN_INVALID or ERR_RSVP_CLOSED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/slots/{slotId}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Remove a validated slot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slot Id",
+                        "name": "slotId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_SLOT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, or ERR_EVENT_CANCELLED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/slots/{slotId}/confirm": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Confirm a slot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slot Id",
+                        "name": "slotId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Confirm Slot parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/slot.ConfirmSlotDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/slot.SlotResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_SLOT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_SLOT_INVALID_STARTS_AT, or ERR_SLOT_INVALID_ENDS_AT",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Code can be: ERR_EVENT_VERSION_CONFLICT when the event was confirmed or changed concurrently",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/slots/{slotId}/reschedule": {
+            "post": {
+                "description": "Requires the MANAGE permission. Drops the validated slot of an upcoming event and searches a new one: the dropped slot stays visible as the previous slot, the other members receive a rescheduling email and the next confirmation is sent as an update. With refreshAvailabilities, the availabilities of the members are cleared so that they enter them again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Reschedule a confirmed event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slot Id",
+                        "name": "slotId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reschedule parameters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/slot.RescheduleSlotDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request - Code can be: ERR_SLOT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, or ERR_EVENT_CANCELLED",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Code can be: ERR_EVENT_VERSION_CONFLICT when the event was rescheduled or changed concurrently",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.ApiError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/v1/events/{eventId}/sse": {
+            "get": {
+                "description": "Establishes a Server-Sent Events connection to receive real-time updates for a specific event",
+                "tags": [
+                    "SSE"
+                ],
+                "summary": "Connect to SSE for event updates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "eventId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of slot update arrays",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/sse.SSESlotUpdateMessage"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid event ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "account.AccountCreateDto": {
+            "type": "object",
+            "required": [
+                "email",
+                "language",
+                "password",
+                "termsAccepted",
+                "termsVersion",
+                "timeZone"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 320,
+                    "minLength": 6
+                },
+                "language": {
+                    "enum": [
+                        "en",
+                        "fr"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/constants.AccountLanguage"
+                        }
+                    ]
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "termsAccepted": {
+                    "type": "boolean"
+                },
+                "termsVersion": {
+                    "type": "string"
+                },
+                "timeZone": {
+                    "type": "string"
+                }
+            }
+        },
+        "account.AccountProviderDto": {
+            "type": "object",
+            "properties": {
+                "provider": {
+                    "$ref": "#/definitions/constants.Provider"
+                }
+            }
+        },
+        "account.AccountResponseDto": {
+            "type": "object",
+            "properties": {
+                "avatarUrl": {
+                    "type": "string"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "isGuest": {
+                    "type": "boolean"
+                },
+                "language": {
+                    "$ref": "#/definitions/constants.AccountLanguage"
+                },
+                "providers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/account.AccountProviderDto"
+                    }
+                },
+                "timeZone": {
+                    "type": "string"
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
+        "account.AccountUpdateDto": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 320,
+                    "minLength": 6
+                },
+                "language": {
+                    "enum": [
+                        "en",
+                        "fr"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/constants.AccountLanguage"
+                        }
+                    ]
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 100
+                },
                 "termsAccepted": {
                     "type": "boolean"
                 },
-                "termsVersion": {
+                "termsVersion": {
+                    "type": "string"
+                },
+                "timeZone": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 3
+                }
+            }
+        },
+        "account.ForgotPasswordDto": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 320,
+                    "minLength": 6
+                }
+            }
+        },
+        "account.ResetPasswordDto": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "activity.ActivityAccountDto": {
+            "type": "object",
+            "properties": {
+                "avatarUrl": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
+        "activity.ActivityListResponseDto": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/activity.ActivityResponseDto"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "maximum": 50,
+                    "minimum": 1
+                },
+                "nextCursor": {
+                    "description": "Cursor of the next page, nil on the last page",
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "total": {
+                    "description": "Not counted in cursor mode",
+                    "type": "integer"
+                }
+            }
+        },
+        "activity.ActivityResponseDto": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "description": "Member who joined, left or whose availability changed, nil when the change is about the event itself",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/activity.ActivityAccountDto"
+                        }
+                    ]
+                },
+                "actor": {
+                    "description": "Nil when the change was made by the app itself or the account was deleted",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/activity.ActivityAccountDto"
+                        }
+                    ]
+                },
+                "after": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "before": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/constants.DomainEventType"
+                }
+            }
+        },
+        "availability.AvailabilityCopyDto": {
+            "type": "object",
+            "required": [
+                "sourceEventId"
+            ],
+            "properties": {
+                "sourceEventId": {
+                    "type": "string"
+                }
+            }
+        },
+        "availability.AvailabilityCreateDto": {
+            "type": "object",
+            "required": [
+                "endsAt",
+                "startsAt"
+            ],
+            "properties": {
+                "endsAt": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "participantId": {
+                    "description": "Set by the event owner or an organiser to enter the availability on behalf of a participant",
+                    "type": "string"
+                },
+                "startsAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "availability.AvailabilityMatrixBucketDto": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "Whether each participant is available for the whole bucket, in the order of the participants",
+                    "type": "array",
+                    "items": {
+                        "type": "boolean"
+                    }
+                },
+                "availableCount": {
+                    "type": "integer"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "isComputedSlot": {
+                    "type": "boolean"
+                },
+                "isValidatedSlot": {
+                    "type": "boolean"
+                },
+                "startsAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "availability.AvailabilityMatrixDto": {
+            "type": "object",
+            "properties": {
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/availability.AvailabilityMatrixBucketDto"
+                    }
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "participants": {
+                    "description": "Members who can submit availabilities, the ones taken into account for the slots",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/availability.AvailabilityMatrixParticipantDto"
+                    }
+                },
+                "resolution": {
+                    "description": "In minutes",
+                    "type": "integer"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/slot.SlotResponseDto"
+                    }
+                }
+            }
+        },
+        "availability.AvailabilityMatrixParticipantDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
+        "availability.AvailabilityResponseDto": {
+            "type": "object",
+            "properties": {
+                "endsAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isProxied": {
+                    "type": "boolean"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "startsAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "availability.AvailabilityUpdateDto": {
+            "type": "object",
+            "properties": {
+                "endsAt": {
+                    "type": "string"
+                },
+                "note": {
+                    "description": "Empty string removes the note",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "startsAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "comment.CommentAuthorDto": {
+            "type": "object",
+            "properties": {
+                "avatarUrl": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
+        "comment.CommentCreateDto": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "description": "Members are mentioned with @ followed by their username",
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "comment.CommentDigestDto": {
+            "type": "object",
+            "required": [
+                "enabled"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "comment.CommentListResponseDto": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/comment.CommentResponseDto"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "maximum": 50,
+                    "minimum": 1
+                },
+                "nextCursor": {
+                    "description": "Cursor of the next page, nil on the last page",
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "total": {
+                    "description": "Not counted in cursor mode",
+                    "type": "integer"
+                }
+            }
+        },
+        "comment.CommentResponseDto": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "$ref": "#/definitions/comment.CommentAuthorDto"
+                },
+                "body": {
+                    "description": "Nil once the comment is deleted",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "type": "string"
+                },
+                "editedAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "removedByModerator": {
+                    "description": "Whether the comment was deleted by the owner of the event rather than by its author",
+                    "type": "boolean"
+                }
+            }
+        },
+        "comment.CommentUpdateDto": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "constants.AccountLanguage": {
+            "type": "string",
+            "enum": [
+                "en",
+                "fr"
+            ],
+            "x-enum-varnames": [
+                "ACCOUNT_LANGUAGE_EN",
+                "ACCOUNT_LANGUAGE_FR"
+            ]
+        },
+        "constants.DomainEventType": {
+            "type": "string",
+            "enum": [
+                "event.status-changed",
+                "event.updated",
+                "member.joined",
+                "member.left",
+                "availability.created",
+                "availability.updated",
+                "availability.deleted",
+                "slot.confirmed",
+                "slot.removed"
+            ],
+            "x-enum-varnames": [
+                "DOMAIN_EVENT_STATUS_CHANGED",
+                "DOMAIN_EVENT_EVENT_UPDATED",
+                "DOMAIN_EVENT_MEMBER_JOINED",
+                "DOMAIN_EVENT_MEMBER_LEFT",
+                "DOMAIN_EVENT_AVAILABILITY_CREATED",
+                "DOMAIN_EVENT_AVAILABILITY_UPDATED",
+                "DOMAIN_EVENT_AVAILABILITY_DELETED",
+                "DOMAIN_EVENT_SLOT_CONFIRMED",
+                "DOMAIN_EVENT_SLOT_REMOVED"
+            ]
+        },
+        "constants.EventRole": {
+            "type": "string",
+            "enum": [
+                "OWNER",
+                "ORGANISER",
+                "PARTICIPANT",
+                "VIEWER"
+            ],
+            "x-enum-varnames": [
+                "EVENT_ROLE_OWNER",
+                "EVENT_ROLE_ORGANISER",
+                "EVENT_ROLE_PARTICIPANT",
+                "EVENT_ROLE_VIEWER"
+            ]
+        },
+        "constants.EventStatus": {
+            "type": "string",
+            "enum": [
+                "IN_DECISION",
+                "UPCOMING",
+                "FINISHED",
+                "CANCELLED"
+            ],
+            "x-enum-varnames": [
+                "EVENT_STATUS_IN_DECISION",
+                "EVENT_STATUS_UPCOMING",
+                "EVENT_STATUS_FINISHED",
+                "EVENT_STATUS_CANCELLED"
+            ]
+        },
+        "constants.JoinRequestStatus": {
+            "type": "string",
+            "enum": [
+                "PENDING",
+                "APPROVED",
+                "REJECTED",
+                "WAITLISTED"
+            ],
+            "x-enum-varnames": [
+                "JOIN_REQUEST_STATUS_PENDING",
+                "JOIN_REQUEST_STATUS_APPROVED",
+                "JOIN_REQUEST_STATUS_REJECTED",
+                "JOIN_REQUEST_STATUS_WAITLISTED"
+            ]
+        },
+        "constants.Provider": {
+            "type": "string",
+            "enum": [
+                "google",
+                "discord",
+                "github"
+            ],
+            "x-enum-varnames": [
+                "PROVIDER_GOOGLE",
+                "PROVIDER_DISCORD",
+                "PROVIDER_GITHUB"
+            ]
+        },
+        "constants.RsvpStatus": {
+            "type": "string",
+            "enum": [
+                "ATTENDING",
+                "DECLINED",
+                "TENTATIVE"
+            ],
+            "x-enum-varnames": [
+                "RSVP_STATUS_ATTENDING",
+                "RSVP_STATUS_DECLINED",
+                "RSVP_STATUS_TENTATIVE"
+            ]
+        },
+        "event.EventBasicResponseDto": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "cancelReason": {
+                    "type": "string"
+                },
+                "cancelledAt": {
+                    "type": "string"
+                },
+                "days": {
+                    "type": "integer"
+                },
+                "decisionDeadline": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "inviteOnly": {
+                    "type": "boolean"
+                },
+                "maxParticipants": {
+                    "type": "integer"
+                },
+                "meetingUrl": {
+                    "type": "string"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "previousSlot": {
+                    "$ref": "#/definitions/slot.SlotResponseDto"
+                },
+                "requiresApproval": {
+                    "type": "boolean"
+                },
+                "rescheduledAt": {
+                    "type": "string"
+                },
+                "startsAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/constants.EventStatus"
+                },
+                "version": {
+                    "description": "Sent back in If-Match to update the event",
+                    "type": "integer"
+                }
+            }
+        },
+        "event.EventCancelDto": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "event.EventCloneDto": {
+            "type": "object",
+            "required": [
+                "startsAt"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Defaults to the name of the source event",
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 5
+                },
+                "startsAt": {
+                    "description": "The end date and the decision deadline are shifted by the same amount",
+                    "type": "string"
+                },
+                "withParticipants": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "event.EventCreateDto": {
+            "type": "object",
+            "required": [
+                "endsAt",
+                "name",
+                "startsAt"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "autoConfirm": {
+                    "type": "boolean"
+                },
+                "autoMeetingLink": {
+                    "type": "boolean"
+                },
+                "days": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "decisionDeadline": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "integer",
+                    "maximum": 23,
+                    "minimum": 0
+                },
+                "inviteOnly": {
+                    "description": "Defaults to true, the event can then only be joined with an invitation link",
+                    "type": "boolean"
+                },
+                "maxParticipants": {
+                    "description": "Members including the owner, the next ones are waitlisted",
+                    "type": "integer",
+                    "minimum": 2
+                },
+                "meetingUrl": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "minutes": {
+                    "type": "integer",
+                    "maximum": 59,
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 5
+                },
+                "requiresApproval": {
+                    "type": "boolean"
+                },
+                "startsAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "event.EventCreateResponseDto": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "inviteOnly": {
+                    "type": "boolean"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "$ref": "#/definitions/event.EventOwnerDto"
+                },
+                "requiresApproval": {
+                    "type": "boolean"
+                },
+                "startsAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/constants.EventStatus"
+                }
+            }
+        },
+        "event.EventFullResponseDto": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "autoConfirm": {
+                    "type": "boolean"
+                },
+                "autoMeetingLink": {
+                    "type": "boolean"
+                },
+                "availabilities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Availability"
+                    }
+                },
+                "cancelReason": {
+                    "type": "string"
+                },
+                "cancelledAt": {
+                    "type": "string"
+                },
+                "days": {
+                    "type": "integer"
+                },
+                "decisionDeadline": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "inviteOnly": {
+                    "type": "boolean"
+                },
+                "maxParticipants": {
+                    "type": "integer"
+                },
+                "meetingUrl": {
+                    "type": "string"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "$ref": "#/definitions/event.EventOwnerDto"
+                },
+                "participants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.EventParticipantDto"
+                    }
+                },
+                "pendingOwnerId": {
+                    "type": "string"
+                },
+                "previousSlot": {
+                    "$ref": "#/definitions/slot.SlotResponseDto"
+                },
+                "requiresApproval": {
+                    "type": "boolean"
+                },
+                "rescheduledAt": {
+                    "type": "string"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Slot"
+                    }
+                },
+                "startsAt": {
                     "type": "string"
                 },
-                "timeZone": {
-                    "type": "string"
+                "status": {
+                    "$ref": "#/definitions/constants.EventStatus"
                 },
-                "username": {
-                    "type": "string",
-                    "maxLength": 30,
-                    "minLength": 3
+                "version": {
+                    "description": "Sent back in If-Match to update the event",
+                    "type": "integer"
                 }
             }
         },
-        "account.ForgotPasswordDto": {
+        "event.EventGuestJoinDto": {
             "type": "object",
             "required": [
-                "email"
+                "displayName"
             ],
             "properties": {
-                "email": {
+                "displayName": {
                     "type": "string",
-                    "maxLength": 320,
-                    "minLength": 6
+                    "maxLength": 30,
+                    "minLength": 3
+                },
+                "invitationToken": {
+                    "description": "Required when the event is invite-only",
+                    "type": "string"
+                },
+                "language": {
+                    "enum": [
+                        "en",
+                        "fr"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/constants.AccountLanguage"
+                        }
+                    ]
+                },
+                "timeZone": {
+                    "type": "string"
                 }
             }
         },
-        "account.ResetPasswordDto": {
+        "event.EventListItemDto": {
             "type": "object",
-            "required": [
-                "password",
-                "token"
-            ],
             "properties": {
-                "password": {
-                    "type": "string",
-                    "maxLength": 100
+                "days": {
+                    "type": "integer"
                 },
-                "token": {
+                "description": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "startsAt": {
                     "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/constants.EventStatus"
                 }
             }
         },
-        "availability.AvailabilityCreateDto": {
+        "event.EventListResponseDto": {
             "type": "object",
-            "required": [
-                "endsAt",
-                "startsAt"
-            ],
             "properties": {
-                "endsAt": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.EventListItemDto"
+                    }
                 },
-                "startsAt": {
+                "limit": {
+                    "type": "integer",
+                    "maximum": 50,
+                    "minimum": 1
+                },
+                "nextCursor": {
+                    "description": "Cursor of the next page, nil on the last page",
                     "type": "string"
+                },
+                "page": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "statusCounts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "total": {
+                    "description": "Not counted in cursor mode",
+                    "type": "integer"
                 }
             }
         },
-        "availability.AvailabilityResponseDto": {
+        "event.EventOwnerDto": {
             "type": "object",
             "properties": {
-                "endsAt": {
+                "avatarUrl": {
                     "type": "string"
                 },
-                "id": {
+                "color": {
                     "type": "string"
                 },
-                "startsAt": {
+                "userName": {
                     "type": "string"
                 }
             }
         },
-        "availability.AvailabilityUpdateDto": {
+        "event.EventParticipantDto": {
             "type": "object",
             "properties": {
-                "endsAt": {
+                "avatarUrl": {
                     "type": "string"
                 },
-                "startsAt": {
+                "color": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isGuest": {
+                    "type": "boolean"
+                },
+                "role": {
+                    "$ref": "#/definitions/constants.EventRole"
+                },
+                "userName": {
                     "type": "string"
                 }
             }
         },
-        "constants.AccountLanguage": {
-            "type": "string",
-            "enum": [
-                "en",
-                "fr"
-            ],
-            "x-enum-varnames": [
-                "ACCOUNT_LANGUAGE_EN",
-                "ACCOUNT_LANGUAGE_FR"
-            ]
-        },
-        "constants.EventStatus": {
-            "type": "string",
-            "enum": [
-                "IN_DECISION",
-                "UPCOMING",
-                "FINISHED"
-            ],
-            "x-enum-varnames": [
-                "EVENT_STATUS_IN_DECISION",
-                "EVENT_STATUS_UPCOMING",
-                "EVENT_STATUS_FINISHED"
-            ]
+        "event.EventProfileDto": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                }
+            }
         },
-        "constants.Provider": {
-            "type": "string",
-            "enum": [
-                "google",
-                "discord",
-                "github"
+        "event.EventRoleUpdateDto": {
+            "type": "object",
+            "required": [
+                "role"
             ],
-            "x-enum-varnames": [
-                "PROVIDER_GOOGLE",
-                "PROVIDER_DISCORD",
-                "PROVIDER_GITHUB"
-            ]
+            "properties": {
+                "role": {
+                    "enum": [
+                        "ORGANISER",
+                        "PARTICIPANT",
+                        "VIEWER"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/constants.EventRole"
+                        }
+                    ]
+                }
+            }
         },
-        "event.EventBasicResponseDto": {
+        "event.EventUpdateDto": {
             "type": "object",
             "properties": {
+                "address": {
+                    "description": "An empty string removes the address or the meeting link",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "autoConfirm": {
+                    "description": "Confirm the slot once every member answered and a single slot remains",
+                    "type": "boolean"
+                },
+                "autoMeetingLink": {
+                    "description": "Generate a meeting link when a slot is confirmed and the event has none",
+                    "type": "boolean"
+                },
                 "days": {
-                    "type": "integer"
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "description": {
+                "decisionDeadline": {
+                    "description": "Setting a new deadline schedules a new reminder, removing it stops the automatic confirmation",
                     "type": "string"
                 },
+                "description": {
+                    "type": "string",
+                    "maxLength": 500
+                },
                 "endsAt": {
                     "type": "string"
                 },
                 "hours": {
+                    "type": "integer",
+                    "maximum": 23,
+                    "minimum": 0
+                },
+                "inviteOnly": {
+                    "type": "boolean"
+                },
+                "maxParticipants": {
+                    "description": "Lowering the capacity keeps the current members, raising or removing it lets waitlisted users in",
+                    "type": "integer",
+                    "minimum": 2
+                },
+                "meetingUrl": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "minutes": {
+                    "type": "integer",
+                    "maximum": 59,
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 5
+                },
+                "removeDecisionDeadline": {
+                    "type": "boolean"
+                },
+                "removeMaxParticipants": {
+                    "type": "boolean"
+                },
+                "requiresApproval": {
+                    "type": "boolean"
+                },
+                "startsAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "eventtemplate.EventTemplateCreateDto": {
+            "type": "object",
+            "required": [
+                "eventId",
+                "name"
+            ],
+            "properties": {
+                "eventId": {
+                    "description": "Event whose settings are saved in the template",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
+                }
+            }
+        },
+        "eventtemplate.EventTemplateResponseDto": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "autoConfirm": {
+                    "type": "boolean"
+                },
+                "autoMeetingLink": {
+                    "type": "boolean"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "duration": {
                     "type": "integer"
                 },
+                "eventName": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
-                "minutes": {
+                "inviteOnly": {
+                    "type": "boolean"
+                },
+                "maxParticipants": {
                     "type": "integer"
                 },
-                "name": {
+                "meetingUrl": {
                     "type": "string"
                 },
-                "startsAt": {
+                "name": {
                     "type": "string"
                 },
-                "status": {
-                    "$ref": "#/definitions/constants.EventStatus"
+                "period": {
+                    "type": "integer"
+                },
+                "requiresApproval": {
+                    "type": "boolean"
                 }
             }
         },
-        "event.EventCreateDto": {
+        "eventtemplate.EventTemplateUseDto": {
             "type": "object",
             "required": [
-                "endsAt",
-                "name",
                 "startsAt"
             ],
             "properties": {
-                "days": {
-                    "type": "integer",
-                    "minimum": 0
-                },
-                "description": {
-                    "type": "string",
-                    "maxLength": 500
-                },
-                "endsAt": {
-                    "type": "string"
-                },
-                "hours": {
-                    "type": "integer",
-                    "maximum": 23,
-                    "minimum": 0
-                },
-                "minutes": {
-                    "type": "integer",
-                    "maximum": 59,
-                    "minimum": 0
-                },
                 "name": {
+                    "description": "Defaults to the event name saved in the template",
                     "type": "string",
                     "maxLength": 100,
                     "minLength": 5
                 },
                 "startsAt": {
+                    "description": "The end of the event is deduced from the search period saved in the template",
                     "type": "string"
                 }
             }
         },
-        "event.EventCreateResponseDto": {
+        "helpers.ApiError": {
             "type": "object",
             "properties": {
-                "days": {
-                    "type": "integer"
-                },
-                "description": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "invitation.InvitationCreateDto": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
                     "type": "string"
                 },
-                "endsAt": {
+                "maxUses": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "invitation.InvitationResponseDto": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
                     "type": "string"
                 },
-                "hours": {
-                    "type": "integer"
+                "expiresAt": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "minutes": {
+                "isActive": {
+                    "type": "boolean"
+                },
+                "lastUsedAt": {
+                    "type": "string"
+                },
+                "maxUses": {
                     "type": "integer"
                 },
-                "name": {
+                "revokedAt": {
                     "type": "string"
                 },
-                "owner": {
-                    "$ref": "#/definitions/event.EventOwnerDto"
+                "token": {
+                    "type": "string"
                 },
-                "startsAt": {
+                "url": {
                     "type": "string"
                 },
-                "status": {
-                    "$ref": "#/definitions/constants.EventStatus"
+                "useCount": {
+                    "type": "integer"
                 }
             }
         },
-        "event.EventFullResponseDto": {
+        "joinrequest.JoinRequestAccountDto": {
             "type": "object",
             "properties": {
-                "availabilities": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Availability"
-                    }
-                },
-                "days": {
-                    "type": "integer"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "endsAt": {
+                "avatarUrl": {
                     "type": "string"
                 },
-                "hours": {
-                    "type": "integer"
-                },
                 "id": {
                     "type": "string"
                 },
-                "minutes": {
-                    "type": "integer"
+                "isGuest": {
+                    "type": "boolean"
                 },
-                "name": {
+                "userName": {
                     "type": "string"
+                }
+            }
+        },
+        "joinrequest.JoinRequestResponseDto": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "$ref": "#/definitions/joinrequest.JoinRequestAccountDto"
                 },
-                "owner": {
-                    "$ref": "#/definitions/event.EventOwnerDto"
-                },
-                "participants": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/event.EventParticipantDto"
-                    }
+                "createdAt": {
+                    "type": "string"
                 },
-                "slots": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Slot"
-                    }
+                "decidedAt": {
+                    "type": "string"
                 },
-                "startsAt": {
+                "id": {
                     "type": "string"
                 },
                 "status": {
-                    "$ref": "#/definitions/constants.EventStatus"
+                    "$ref": "#/definitions/constants.JoinRequestStatus"
                 }
             }
         },
-        "event.EventListItemDto": {
+        "model.Availability": {
             "type": "object",
             "properties": {
-                "days": {
-                    "type": "integer"
-                },
-                "description": {
-                    "type": "string"
-                },
                 "endsAt": {
                     "type": "string"
                 },
-                "hours": {
-                    "type": "integer"
-                },
                 "id": {
                     "type": "string"
                 },
-                "minutes": {
-                    "type": "integer"
+                "isProxied": {
+                    "type": "boolean"
                 },
-                "name": {
+                "note": {
                     "type": "string"
                 },
                 "startsAt": {
                     "type": "string"
                 },
-                "status": {
-                    "$ref": "#/definitions/constants.EventStatus"
+                "userName": {
+                    "type": "string"
                 }
             }
         },
-        "event.EventOwnerDto": {
+        "model.Slot": {
             "type": "object",
             "properties": {
-                "avatarUrl": {
+                "endsAt": {
                     "type": "string"
                 },
-                "color": {
+                "id": {
                     "type": "string"
                 },
-                "userName": {
+                "isValidated": {
+                    "type": "boolean"
+                },
+                "startsAt": {
                     "type": "string"
                 }
             }
         },
-        "event.EventParticipantDto": {
+        "ownership.OwnershipAdminTransferDto": {
             "type": "object",
+            "required": [
+                "newOwnerId"
+            ],
             "properties": {
-                "avatarUrl": {
-                    "type": "string"
-                },
-                "color": {
-                    "type": "string"
-                },
-                "userName": {
+                "newOwnerId": {
                     "type": "string"
                 }
             }
         },
-        "event.EventProfileDto": {
+        "ownership.OwnershipNominateDto": {
             "type": "object",
+            "required": [
+                "nomineeId"
+            ],
             "properties": {
-                "color": {
+                "nomineeId": {
                     "type": "string"
                 }
             }
         },
-        "event.EventUpdateDto": {
+        "ownership.OwnershipResponseDto": {
             "type": "object",
             "properties": {
-                "days": {
-                    "type": "integer",
-                    "minimum": 0
-                },
-                "description": {
-                    "type": "string",
-                    "maxLength": 500
-                },
-                "endsAt": {
+                "ownerId": {
                     "type": "string"
                 },
-                "hours": {
-                    "type": "integer",
-                    "maximum": 23,
-                    "minimum": 0
-                },
-                "minutes": {
-                    "type": "integer",
-                    "maximum": 59,
-                    "minimum": 0
-                },
-                "name": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 5
-                },
-                "startsAt": {
+                "pendingOwnerId": {
                     "type": "string"
                 }
             }
         },
-        "helpers.ApiError": {
+        "rsvp.RsvpAnswerDto": {
             "type": "object",
+            "required": [
+                "status"
+            ],
             "properties": {
-                "code": {
-                    "type": "string"
+                "status": {
+                    "enum": [
+                        "ATTENDING",
+                        "DECLINED",
+                        "TENTATIVE"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/constants.RsvpStatus"
+                        }
+                    ]
                 }
             }
         },
-        "lib.Pagination-event_EventListItemDto": {
+        "rsvp.RsvpCountsDto": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/event.EventListItemDto"
-                    }
+                "attending": {
+                    "type": "integer"
                 },
-                "limit": {
-                    "type": "integer",
-                    "maximum": 50,
-                    "minimum": 1
+                "declined": {
+                    "type": "integer"
                 },
-                "page": {
-                    "type": "integer",
-                    "maximum": 100,
-                    "minimum": 1
+                "noAnswer": {
+                    "type": "integer"
                 },
-                "total": {
+                "tentative": {
                     "type": "integer"
                 }
             }
         },
-        "model.Availability": {
+        "rsvp.RsvpResponseDto": {
             "type": "object",
             "properties": {
-                "endsAt": {
+                "accountId": {
                     "type": "string"
                 },
-                "id": {
+                "status": {
+                    "$ref": "#/definitions/constants.RsvpStatus"
+                },
+                "updatedAt": {
                     "type": "string"
+                }
+            }
+        },
+        "rsvp.RsvpSummaryDto": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rsvp.RsvpResponseDto"
+                    }
                 },
-                "startsAt": {
+                "counts": {
+                    "$ref": "#/definitions/rsvp.RsvpCountsDto"
+                },
+                "slotId": {
                     "type": "string"
+                }
+            }
+        },
+        "rsvp.RsvpTokenAnswerDto": {
+            "type": "object",
+            "required": [
+                "status",
+                "token"
+            ],
+            "properties": {
+                "status": {
+                    "enum": [
+                        "ATTENDING",
+                        "DECLINED",
+                        "TENTATIVE"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/constants.RsvpStatus"
+                        }
+                    ]
                 },
-                "userName": {
+                "token": {
                     "type": "string"
                 }
             }
         },
-        "model.Slot": {
+        "rsvp.RsvpTokenResponseDto": {
             "type": "object",
             "properties": {
                 "endsAt": {
                     "type": "string"
                 },
-                "id": {
+                "eventId": {
                     "type": "string"
                 },
-                "isValidated": {
-                    "type": "boolean"
+                "eventName": {
+                    "type": "string"
                 },
                 "startsAt": {
                     "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/constants.RsvpStatus"
                 }
             }
         },
@@ -1625,6 +4322,19 @@ const docTemplate = `{
                 }
             }
         },
+        "slot.RescheduleSlotDto": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "refreshAvailabilities": {
+                    "description": "Clear the availabilities of the members so that they enter them again",
+                    "type": "boolean"
+                }
+            }
+        },
         "slot.SlotResponseDto": {
             "type": "object",
             "properties": {
@@ -38,7 +38,7 @@ func NewAccountController(ctl *AccountController) *AccountController {
 }
 
 // @Summary Create an account
-// @Description Create a new account with the provided parameters.
+// @Description Create a new account with the provided parameters. When a guest token cookie is present, the guest account is upgraded and keeps its events and availabilities.
 // @Tags Account
 // @Accept json
 // @Produce json
@@ -52,7 +52,9 @@ func (ctl *AccountController) Create(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ctl.accountService.Create(&data)
+	guest := guard.GetGuestClaims(c)
+
+	tokens, err := ctl.accountService.Create(&data, guest)
 	if err != nil {
 		helpers.HandleJSONResponse(c, nil, err)
 		return
@@ -60,6 +62,9 @@ func (ctl *AccountController) Create(c *gin.Context) {
 
 	lib.SetAccessTokenCookie(c, tokens.AccessToken, 0)
 	lib.SetRefreshTokenCookie(c, tokens.RefreshToken, 0)
+	if guest != nil {
+		lib.SetGuestTokenCookie(c, "", -1)
+	}
 
 	helpers.HandleJSONResponse(c, nil, err)
 }
@@ -15,5 +15,6 @@ func MapToAccountResponseDto(a model.Account) AccountResponseDto {
 		Color:     a.Color,
 		TimeZone:  a.TimeZone,
 		Providers: providers,
+		IsGuest:   a.IsGuest,
 	}
 }
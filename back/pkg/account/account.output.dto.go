@@ -16,4 +16,5 @@ type AccountResponseDto struct {
 	Color     string                    `json:"color"`
 	TimeZone  string                    `json:"timeZone"`
 	Providers []AccountProviderDto      `json:"providers"`
+	IsGuest   bool                      `json:"isGuest"`
 }
@@ -65,7 +65,8 @@ func (s *AccountService) CheckUserNameAvailability(userName string, excludeUserI
 	return false, nil
 }
 
-func (s *AccountService) Create(data *AccountCreateDto) (AccountTokensDto, error) {
+// Create creates a new account, or upgrades the guest account when guest claims are given
+func (s *AccountService) Create(data *AccountCreateDto, guest *guard.Claims) (AccountTokensDto, error) {
 	var tokens AccountTokensDto
 	// Validate input
 	if !slices.Contains(constants.TERMS_VERSIONS, constants.TermsVersion(data.TermsVersion)) {
@@ -98,11 +99,22 @@ func (s *AccountService) Create(data *AccountCreateDto) (AccountTokensDto, error
 	colors := constants.COLORS
 	color := colors[mathrand.Intn(len(colors))]
 
+	// Upgrade the guest account so it keeps its events and availabilities
+	var guestAccount model.Account
+	if guest != nil && guest.Guest {
+		if err := s.accountRepository.FindOneById(guest.Id, &guestAccount); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return tokens, err
+		}
+	}
+
 	// Create account
 	var account model.Account
 	accountId := uuid.New()
+	if guestAccount.IsGuest {
+		accountId = guestAccount.Id
+	}
 	avatarData, avatarUrl := s.avatarService.FetchAndStoreGravatar(accountId.String(), accountId)
-	if err := s.accountRepository.Create(repository.AccountCreateDto{
+	createDto := repository.AccountCreateDto{
 		Id:           accountId,
 		Email:        &data.Email,
 		Color:        string(color),
@@ -112,7 +124,24 @@ func (s *AccountService) Create(data *AccountCreateDto) (AccountTokensDto, error
 		AvatarUrl:    avatarUrl,
 		AvatarData:   avatarData,
 		TimeZone:     *timeZone,
-	}, &account); err != nil {
+	}
+	if guestAccount.IsGuest {
+		// Keep the display name as username when nobody else uses it
+		if guestAccount.UserName != nil {
+			isUserNameAvailable, err := s.CheckUserNameAvailability(*guestAccount.UserName, &guestAccount.Id)
+			if err != nil {
+				return tokens, err
+			}
+			if isUserNameAvailable {
+				createDto.UserName = guestAccount.UserName
+			}
+		}
+
+		account = guestAccount
+		if err := s.accountRepository.UpgradeGuest(createDto, &account); err != nil {
+			return tokens, err
+		}
+	} else if err := s.accountRepository.Create(createDto, &account); err != nil {
 		return tokens, err
 	}
 
@@ -126,7 +155,9 @@ func (s *AccountService) Create(data *AccountCreateDto) (AccountTokensDto, error
 
 	token, err := s.signinService.GenerateTokens(claims)
 	if err != nil {
-		_ = s.accountRepository.Delete(account.Id)
+		if !guestAccount.IsGuest {
+			_ = s.accountRepository.Delete(account.Id)
+		}
 		return tokens, err
 	}
 
@@ -0,0 +1,62 @@
+package activity
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	"app/commons/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ActivityController struct {
+	activityService *ActivityService
+}
+
+func NewActivityController(ctl *ActivityController) *ActivityController {
+	if ctl != nil {
+		return ctl
+	}
+
+	return &ActivityController{
+		activityService: NewActivityService(nil),
+	}
+}
+
+// @Summary Get the activity log of an event
+// @Description Lists the joins, leaves, availability changes, slot confirmations and removals, status changes and edits of the event, the newest first, with the values of the changed fields before and after each change.
+// @Description New entries are also sent on the SSE connection of the event as activity frames.
+// @Description In cursor mode the total is not returned, and the next page is requested with the nextCursor of the response until it is missing.
+// @Tags Activity
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param cursor query string false "Cursor mode: empty for the first page, then the nextCursor of the previous page"
+// @Security BearerAuth
+// @Success 200 {object} ActivityListResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_INVALID_PAGINATION_PARAMS, or ERR_INVALID_PAGINATION_CURSOR"
+// @Router /api/v1/events/{eventId}/activity [get]
+func (ctl *ActivityController) List(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	var result ActivityListResponseDto
+	if err := result.ParseCursorQuery(c); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	err = ctl.activityService.List(eventId, user, &result)
+	helpers.HandleJSONResponse(c, result, err)
+}
@@ -0,0 +1,27 @@
+package activity
+
+import model "app/db/models"
+
+func mapToAccountDto(account *model.Account) *ActivityAccountDto {
+	if account == nil {
+		return nil
+	}
+
+	return &ActivityAccountDto{
+		Id:        account.Id,
+		UserName:  account.UserName,
+		AvatarUrl: account.AvatarUrl,
+	}
+}
+
+func MapToActivityResponseDto(a model.ActivityLog) ActivityResponseDto {
+	return ActivityResponseDto{
+		Id:        a.Id,
+		Type:      a.Type,
+		Actor:     mapToAccountDto(a.Actor),
+		Account:   mapToAccountDto(a.Account),
+		Before:    a.Before,
+		After:     a.After,
+		CreatedAt: a.CreatedAt,
+	}
+}
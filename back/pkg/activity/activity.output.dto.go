@@ -0,0 +1,34 @@
+package activity
+
+import (
+	"app/commons/constants"
+	"app/commons/lib"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActivityAccountDto - member who made the change or is concerned by it
+type ActivityAccountDto struct {
+	Id        uuid.UUID `json:"id"`
+	UserName  *string   `json:"userName"`
+	AvatarUrl string    `json:"avatarUrl"`
+}
+
+// ActivityResponseDto - entry of the activity log, also the SSE activity payload
+type ActivityResponseDto struct {
+	Id   uuid.UUID                 `json:"id"`
+	Type constants.DomainEventType `json:"type"`
+	// Nil when the change was made by the app itself or the account was deleted
+	Actor *ActivityAccountDto `json:"actor"`
+	// Member who joined, left or whose availability changed, nil when the change is about the event itself
+	Account   *ActivityAccountDto `json:"account"`
+	Before    map[string]any      `json:"before"`
+	After     map[string]any      `json:"after"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+// ActivityListResponseDto - GET /events/:id/activity (paginated, newest entries first)
+type ActivityListResponseDto struct {
+	lib.Pagination[ActivityResponseDto]
+}
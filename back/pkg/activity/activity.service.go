@@ -0,0 +1,118 @@
+package activity
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	"app/commons/lib"
+	model "app/db/models"
+	"app/db/repository"
+	"app/pkg/domainevent"
+	"app/pkg/sse"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ActivityService keeps the activity log of the events from the domain events published by the other services
+type ActivityService struct {
+	activityLogRepository *repository.ActivityLogRepository
+	eventRepository       *repository.EventRepository
+	sseService            *sse.SSEService
+}
+
+func NewActivityService(service *ActivityService) *ActivityService {
+	if service != nil {
+		return service
+	}
+
+	return &ActivityService{
+		activityLogRepository: repository.NewActivityLogRepository(nil),
+		eventRepository:       repository.NewEventRepository(nil),
+		sseService:            sse.GetSSEService(),
+	}
+}
+
+// Subscribe records the domain events of the activity types published on the bus
+func (s *ActivityService) Subscribe(bus *domainevent.Bus) {
+	for _, activityType := range constants.ActivityTypes {
+		bus.Subscribe(activityType, s.Record)
+	}
+}
+
+// Record appends the domain event to the activity log of its event and streams the entry to the connected members.
+// The entry is written before Publish returns, so that it is not lost and a failure stays within the recover of the bus.
+func (s *ActivityService) Record(event domainevent.DomainEvent) {
+	before, after, hasChange := changeOf(event)
+	if !hasChange {
+		return
+	}
+
+	activityLog := model.ActivityLog{
+		Id:        uuid.New(),
+		EventId:   event.EventId,
+		ActorId:   event.ActorId,
+		AccountId: event.AccountId,
+		Type:      event.Type,
+		Before:    before,
+		After:     after,
+		CreatedAt: event.OccurredAt.UTC(),
+	}
+	if err := s.activityLogRepository.Create(&activityLog); err != nil {
+		return
+	}
+
+	// Reloaded with the accounts and the values as they are stored
+	if err := s.activityLogRepository.FindOneById(activityLog.Id, &activityLog); err != nil {
+		return
+	}
+	s.sseService.Broadcast(activityLog.EventId, constants.SSE_EVENT_ACTIVITY, MapToActivityResponseDto(activityLog))
+}
+
+// changeOf returns the values changed by the domain event, false when nothing changed
+func changeOf(event domainevent.DomainEvent) (map[string]any, map[string]any, bool) {
+	switch payload := event.Payload.(type) {
+	case domainevent.Change:
+		return payload.Before, payload.After, !payload.IsEmpty()
+	case domainevent.StatusChanged:
+		return map[string]any{"status": payload.From}, map[string]any{"status": payload.To}, true
+	}
+
+	return nil, nil, false
+}
+
+// List returns a page of the activity log of the event, the newest entries first.
+// In cursor mode the page starts after the cursor and the entries are not counted.
+func (s *ActivityService) List(eventId uuid.UUID, user *guard.Claims, result *ActivityListResponseDto) error {
+	var event model.Event
+	if err := s.eventRepository.FindOneById(eventId, &event); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return err
+	}
+	if _, isMember := event.RoleOf(&user.Id); !isMember {
+		return constants.ERR_EVENT_NOT_FOUND.Err
+	}
+
+	activityLogs, err := lib.LoadPage(
+		&result.Pagination,
+		func() (int64, error) { return s.activityLogRepository.CountByEventId(eventId) },
+		func(after *repository.CreatedAtCursor, limit int, offset int) ([]model.ActivityLog, error) {
+			return s.activityLogRepository.FindByEventId(eventId, after, limit, offset)
+		},
+		func(activityLog model.ActivityLog) repository.CreatedAtCursor {
+			return repository.NewCreatedAtCursor(activityLog.Id, activityLog.CreatedAt)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	result.Data = make([]ActivityResponseDto, 0, len(activityLogs))
+	for _, activityLog := range activityLogs {
+		result.Data = append(result.Data, MapToActivityResponseDto(activityLog))
+	}
+
+	return nil
+}
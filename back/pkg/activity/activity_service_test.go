@@ -0,0 +1,31 @@
+package activity
+
+import (
+	"app/commons/constants"
+	"app/pkg/domainevent"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeOf(t *testing.T) {
+	eventId := uuid.New()
+
+	before, after, hasChange := changeOf(domainevent.NewStatusChanged(eventId, constants.EVENT_STATUS_UPCOMING, constants.EVENT_STATUS_FINISHED, nil))
+	assert.True(t, hasChange)
+	assert.Equal(t, map[string]any{"status": constants.EVENT_STATUS_UPCOMING}, before)
+	assert.Equal(t, map[string]any{"status": constants.EVENT_STATUS_FINISHED}, after)
+
+	before, after, hasChange = changeOf(domainevent.NewMemberJoined(eventId, uuid.New(), constants.EVENT_ROLE_PARTICIPANT, nil))
+	assert.True(t, hasChange)
+	assert.Nil(t, before)
+	assert.Equal(t, map[string]any{"role": constants.EVENT_ROLE_PARTICIPANT}, after)
+
+	fields := map[string]any{"name": "Team meeting"}
+	_, _, hasChange = changeOf(domainevent.NewChange(constants.DOMAIN_EVENT_EVENT_UPDATED, eventId, nil, fields, fields))
+	assert.False(t, hasChange, "an update keeping every value is not logged")
+
+	_, _, hasChange = changeOf(domainevent.DomainEvent{Type: constants.DOMAIN_EVENT_EVENT_UPDATED, EventId: eventId})
+	assert.False(t, hasChange)
+}
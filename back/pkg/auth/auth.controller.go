@@ -65,6 +65,7 @@ func (ctl *AuthController) Logout(c *gin.Context) {
 	// Clear cookies
 	lib.SetAccessTokenCookie(c, "", -1)
 	lib.SetRefreshTokenCookie(c, "", -1)
+	lib.SetGuestTokenCookie(c, "", -1)
 
 	helpers.HandleJSONResponse(c, nil, nil)
 }
@@ -4,9 +4,13 @@ import (
 	"app/commons/constants"
 	"app/commons/guard"
 	"app/commons/helpers"
+	"encoding/csv"
+	"fmt"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 )
 
 type AvailabilityController struct {
@@ -61,7 +65,7 @@ func (ctl *AvailabilityController) getAvailabilityIdParam(c *gin.Context) (avail
 // @Param data body AvailabilityCreateDto true "Availability parameters"
 // @Security BearerAuth
 // @Success 200 {object} AvailabilityResponseDto
-// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ENDED, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_START_AFTER_END, ERR_AVAILABILITY_DURATION_TOO_SHORT, ERR_AVAILABILITY_INVALID_TIME_INTERVAL, ERR_AVAILABILITY_START_BEFORE_EVENT, or ERR_AVAILABILITY_END_AFTER_EVENT"
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_EVENT_ACCESS_DENIED, ERR_AVAILABILITY_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, ERR_EVENT_START_AFTER_END, ERR_AVAILABILITY_DURATION_TOO_SHORT, ERR_AVAILABILITY_INVALID_TIME_INTERVAL, ERR_AVAILABILITY_START_BEFORE_EVENT, or ERR_AVAILABILITY_END_AFTER_EVENT"
 // @Router /api/v1/events/{eventId}/availability [post]
 func (ctl *AvailabilityController) Create(c *gin.Context) {
 	var data AvailabilityCreateDto
@@ -86,6 +90,38 @@ func (ctl *AvailabilityController) Create(c *gin.Context) {
 	helpers.HandleJSONResponse(c, availability, err)
 }
 
+// @Summary Copy availabilities from another event
+// @Tags Availability
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Param data body AvailabilityCopyDto true "Copy parameters"
+// @Security BearerAuth
+// @Success 200 {array} AvailabilityResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_EVENT_ACCESS_DENIED, or ERR_AVAILABILITY_COPY_SAME_EVENT"
+// @Router /api/v1/events/{eventId}/availability/copy [post]
+func (ctl *AvailabilityController) CopyFromEvent(c *gin.Context) {
+	var data AvailabilityCopyDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	availabilities, err := ctl.availabilityService.CopyFromEvent(&data, eventId, user)
+	helpers.HandleJSONResponse(c, availabilities, err)
+}
+
 // @Summary Update an availability
 // @Tags Availability
 // @Accept json
@@ -94,7 +130,7 @@ func (ctl *AvailabilityController) Create(c *gin.Context) {
 // @Param data body AvailabilityUpdateDto true "Availability parameters"
 // @Security BearerAuth
 // @Success 200 {object} AvailabilityResponseDto
-// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_AVAILABILITY_NOT_FOUND, ERR_AVAILABILITY_ACCESS_DENIED, ERR_EVENT_NOT_FOUND, ERR_EVENT_ENDED, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_START_AFTER_END, ERR_AVAILABILITY_DURATION_TOO_SHORT, ERR_AVAILABILITY_INVALID_TIME_INTERVAL, ERR_AVAILABILITY_START_BEFORE_EVENT, or ERR_AVAILABILITY_END_AFTER_EVENT"
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_AVAILABILITY_NOT_FOUND, ERR_AVAILABILITY_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, ERR_EVENT_NOT_FOUND, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_START_AFTER_END, ERR_AVAILABILITY_DURATION_TOO_SHORT, ERR_AVAILABILITY_INVALID_TIME_INTERVAL, ERR_AVAILABILITY_START_BEFORE_EVENT, or ERR_AVAILABILITY_END_AFTER_EVENT"
 // @Router /api/v1/availabilities/{availabilityId} [patch]
 func (ctl *AvailabilityController) Update(c *gin.Context) {
 	var data AvailabilityUpdateDto
@@ -126,7 +162,7 @@ func (ctl *AvailabilityController) Update(c *gin.Context) {
 // @Param availabilityId path string true "Availability ID"
 // @Security BearerAuth
 // @Success 200
-// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_AVAILABILITY_NOT_FOUND, ERR_AVAILABILITY_ACCESS_DENIED, ERR_EVENT_ACCESS_DENIED, or ERR_EVENT_ENDED"
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_AVAILABILITY_NOT_FOUND, ERR_AVAILABILITY_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, or ERR_EVENT_CANCELLED"
 // @Router /api/v1/availabilities/{availabilityId} [delete]
 func (ctl *AvailabilityController) Delete(c *gin.Context) {
 	var user *guard.Claims
@@ -145,3 +181,55 @@ func (ctl *AvailabilityController) Delete(c *gin.Context) {
 
 	helpers.HandleJSONResponse(c, nil, err)
 }
+
+// @Summary Export the availability matrix of an event
+// @Description Returns one bucket per interval of the availability grid over the part of the event covered by availabilities or slots, telling which participants are available for the whole bucket and whether it belongs to a computed slot or to the validated slot. This part cannot exceed 31 days.
+// @Description With format=csv, the matrix is downloaded as a CSV file with one row per bucket, one 1/0 column per participant, the number of available participants and the slot of the bucket ("computed" or "validated").
+// @Tags Availability
+// @Accept json
+// @Produce json
+// @Produce text/csv
+// @Param eventId path string true "Event ID"
+// @Param format query string false "Export format" Enums(json, csv) default(json)
+// @Security BearerAuth
+// @Success 200 {object} AvailabilityMatrixDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_AVAILABILITY_INVALID_EXPORT_FORMAT or ERR_AVAILABILITY_EXPORT_TOO_LARGE"
+// @Router /api/v1/events/{eventId}/availability/export [get]
+func (ctl *AvailabilityController) Export(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	var query AvailabilityExportQueryDto
+	if err := c.ShouldBindQuery(&query); err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_AVAILABILITY_INVALID_EXPORT_FORMAT.Err)
+		return
+	}
+
+	matrix, err := ctl.availabilityService.Export(eventId, user)
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+	if query.Format != "csv" {
+		helpers.HandleJSONResponse(c, matrix.Dto(), nil)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="availabilities-%s.csv"`, eventId.String()))
+	c.Status(http.StatusOK)
+
+	writer := csv.NewWriter(c.Writer)
+	if err := WriteAvailabilityMatrixCsv(writer, matrix); err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("AVAILABILITY_CONTROLLER::EXPORT Failed to write CSV")
+	}
+}
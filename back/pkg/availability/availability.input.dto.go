@@ -2,14 +2,29 @@ package availability
 
 import (
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type AvailabilityCreateDto struct {
 	StartsAt time.Time `json:"startsAt" binding:"required"`
 	EndsAt   time.Time `json:"endsAt" binding:"required"`
+	Note     *string   `json:"note" binding:"omitempty,max=255"`
+	// Set by the event owner or an organiser to enter the availability on behalf of a participant
+	ParticipantId *uuid.UUID `json:"participantId"`
 }
 
 type AvailabilityUpdateDto struct {
 	StartsAt *time.Time `json:"startsAt"`
 	EndsAt   *time.Time `json:"endsAt"`
+	Note     *string    `json:"note" binding:"omitempty,max=255"` // Empty string removes the note
+}
+
+type AvailabilityCopyDto struct {
+	SourceEventId uuid.UUID `json:"sourceEventId" binding:"required"`
+}
+
+// AvailabilityExportQueryDto - GET /events/:id/availability/export
+type AvailabilityExportQueryDto struct {
+	Format string `form:"format" binding:"omitempty,oneof=csv json"`
 }
@@ -1,11 +1,73 @@
 package availability
 
-import model "app/db/models"
+import (
+	model "app/db/models"
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"time"
+)
 
 func MapToAvailabilityResponseDto(a model.Availability) AvailabilityResponseDto {
 	return AvailabilityResponseDto{
-		Id:       a.Id,
-		StartsAt: a.StartsAt,
-		EndsAt:   a.EndsAt,
+		Id:        a.Id,
+		StartsAt:  a.StartsAt,
+		EndsAt:    a.EndsAt,
+		Note:      a.Note,
+		IsProxied: a.ProxyAccountId != nil,
 	}
 }
+
+// WriteAvailabilityMatrixCsv writes the CSV export one record at a time, each bucket as soon as it is computed:
+// one row per bucket with one 1/0 column per participant, the number of available participants and the slot
+// the bucket belongs to, "validated" or "computed"
+func WriteAvailabilityMatrixCsv(writer *csv.Writer, m *AvailabilityMatrix) error {
+	header := make([]string, 0, len(m.Participants)+4)
+	header = append(header, "startsAt", "endsAt")
+	for _, participant := range m.Participants {
+		name := participant.Id.String()
+		if participant.UserName != nil {
+			name = *participant.UserName
+		}
+		header = append(header, csvSafe(name))
+	}
+	header = append(header, "available", "slot")
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	// The record is reused for every bucket, csv.Writer does not keep it
+	record := make([]string, 0, len(header))
+	if err := m.EachBucket(func(bucket AvailabilityMatrixBucketDto) error {
+		record = append(record[:0], bucket.StartsAt.Format(time.RFC3339), bucket.EndsAt.Format(time.RFC3339))
+		for _, available := range bucket.Available {
+			if available {
+				record = append(record, "1")
+			} else {
+				record = append(record, "0")
+			}
+		}
+
+		slot := ""
+		if bucket.IsValidatedSlot {
+			slot = "validated"
+		} else if bucket.IsComputedSlot {
+			slot = "computed"
+		}
+		return writer.Write(append(record, strconv.Itoa(bucket.AvailableCount), slot))
+	}); err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvSafe prevents spreadsheets from evaluating a user-provided value as a formula
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
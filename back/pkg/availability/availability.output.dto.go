@@ -1,6 +1,7 @@
 package availability
 
 import (
+	"app/pkg/slot"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,7 +9,36 @@ import (
 
 // AvailabilityResponseDto - POST /events/:id/availability and PATCH /availabilities/:id
 type AvailabilityResponseDto struct {
+	Id        uuid.UUID `json:"id"`
+	StartsAt  time.Time `json:"startsAt"`
+	EndsAt    time.Time `json:"endsAt"`
+	Note      *string   `json:"note"`
+	IsProxied bool      `json:"isProxied"`
+}
+
+// AvailabilityMatrixParticipantDto - column of the availability matrix
+type AvailabilityMatrixParticipantDto struct {
 	Id       uuid.UUID `json:"id"`
+	UserName *string   `json:"userName"`
+}
+
+// AvailabilityMatrixBucketDto - row of the availability matrix
+type AvailabilityMatrixBucketDto struct {
 	StartsAt time.Time `json:"startsAt"`
 	EndsAt   time.Time `json:"endsAt"`
+	// Whether each participant is available for the whole bucket, in the order of the participants
+	Available       []bool `json:"available"`
+	AvailableCount  int    `json:"availableCount"`
+	IsComputedSlot  bool   `json:"isComputedSlot"`
+	IsValidatedSlot bool   `json:"isValidatedSlot"`
+}
+
+// AvailabilityMatrixDto - GET /events/:id/availability/export
+type AvailabilityMatrixDto struct {
+	EventId    uuid.UUID `json:"eventId"`
+	Resolution int       `json:"resolution"` // In minutes
+	// Members who can submit availabilities, the ones taken into account for the slots
+	Participants []AvailabilityMatrixParticipantDto `json:"participants"`
+	Buckets      []AvailabilityMatrixBucketDto      `json:"buckets"`
+	Slots        []slot.SlotResponseDto             `json:"slots"`
 }
@@ -5,18 +5,35 @@ import (
 	"app/commons/guard"
 	model "app/db/models"
 	"app/db/repository"
+	"app/pkg/domainevent"
+	"app/pkg/lifecycle"
+	"app/pkg/mail"
 	"app/pkg/slot"
+	"errors"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
+const (
+	noteMaxLength = 255
+	noteSeparator = "; "
+	gridInterval  = 5 * time.Minute     // Availabilities are aligned on this grid, which is also the resolution of the availability matrix
+	maxExportSpan = 31 * 24 * time.Hour // Longest period of the availability matrix, beyond it the export is refused
+)
+
 type AvailabilityService struct {
 	slotService            *slot.SlotService
 	availabilityRepository *repository.AvailabilityRepository
 	eventRepository        *repository.EventRepository
+	mailService            *mail.MailService
+	lifecycleService       *lifecycle.LifecycleService
+	domainEventBus         *domainevent.Bus
 	locks                  sync.Map // Map to store mutexes per user ID
 }
 
@@ -29,6 +46,9 @@ func NewAvailabilityService(service *AvailabilityService) *AvailabilityService {
 		slotService:            slot.NewSlotService(nil),
 		availabilityRepository: repository.NewAvailabilityRepository(nil),
 		eventRepository:        repository.NewEventRepository(nil),
+		mailService:            mail.NewMailService(nil),
+		lifecycleService:       lifecycle.NewLifecycleService(nil),
+		domainEventBus:         domainevent.GetBus(),
 	}
 }
 
@@ -48,7 +68,7 @@ func (s *AvailabilityService) validateAvailabilityTimes(startsAt, endsAt time.Ti
 
 	// Prevent creating/updating availabilities not aligned on 5 minutes interval
 	// Check if times are exactly on 5-minute boundaries (no seconds or sub-seconds)
-	if startsAt.Truncate(5*time.Minute) != startsAt || endsAt.Truncate(5*time.Minute) != endsAt {
+	if startsAt.Truncate(gridInterval) != startsAt || endsAt.Truncate(gridInterval) != endsAt {
 		return constants.ERR_AVAILABILITY_INVALID_TIME_INTERVAL.Err
 	}
 
@@ -72,21 +92,70 @@ func (s *AvailabilityService) validateEventAccess(eventId uuid.UUID, userId *uui
 	}
 
 	// Check if event is still in decision
-	if hasStatus, err := event.CheckAndAutoUpdateStatus(s.eventRepository.Updates, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION}); !hasStatus || err != nil {
+	if hasStatus, err := event.CheckAndAutoUpdateStatus(s.lifecycleService.Finish, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION}); !hasStatus || err != nil {
 		if err != nil {
 			return err
 		}
-		return constants.ERR_EVENT_ENDED.Err
+		return event.LockedError()
 	}
 
-	// Check if user has access to the event
-	if !event.HasUserAccess(userId) {
+	// Check if user can submit availabilities, viewers are read-only
+	if !event.Can(userId, constants.EVENT_PERMISSION_CONTRIBUTE) {
 		return constants.ERR_EVENT_ACCESS_DENIED.Err
 	}
 
 	return nil
 }
 
+// validateProxyAccess validates that the user can manage the availabilities of the participant on their behalf
+func (s *AvailabilityService) validateProxyAccess(event *model.Event, userId *uuid.UUID, participantId uuid.UUID) error {
+	// Only the owner and organisers can act on behalf of a participant
+	if !event.Can(userId, constants.EVENT_PERMISSION_MANAGE) {
+		return constants.ERR_AVAILABILITY_ACCESS_DENIED.Err
+	}
+
+	if !event.Can(&participantId, constants.EVENT_PERMISSION_CONTRIBUTE) {
+		return constants.ERR_EVENT_PARTICIPANT_NOT_FOUND.Err
+	}
+
+	return nil
+}
+
+// notifyProxyChange emails the participant about a change made on their behalf
+func (s *AvailabilityService) notifyProxyChange(
+	participant model.Account,
+	event model.Event,
+	user *guard.Claims,
+	change constants.AvailabilityChange,
+	startsAt time.Time,
+	endsAt time.Time,
+) {
+	proxyName := ""
+	if user.Username != nil {
+		proxyName = *user.Username
+	}
+
+	log.Info().
+		Str("eventId", event.Id.String()).
+		Str("participantId", participant.Id.String()).
+		Str("proxyId", user.Id.String()).
+		Str("change", string(change)).
+		Msg("AVAILABILITY_SERVICE::PROXY Availability changed on behalf of participant")
+
+	go s.mailService.SendAvailabilityProxyEmail(participant, event, proxyName, change, startsAt, endsAt)
+}
+
+// findParticipantAccount returns the account of a participant from the event relations
+func findParticipantAccount(event *model.Event, participantId uuid.UUID) model.Account {
+	for _, accountEvent := range event.AccountEvents {
+		if accountEvent.AccountId == participantId {
+			return accountEvent.Account
+		}
+	}
+
+	return model.Account{Id: participantId}
+}
+
 func (s *AvailabilityService) Create(data *AvailabilityCreateDto, eventId uuid.UUID, user *guard.Claims) (AvailabilityResponseDto, error) {
 	// Get event and validate access
 	var event model.Event
@@ -94,6 +163,16 @@ func (s *AvailabilityService) Create(data *AvailabilityCreateDto, eventId uuid.U
 		return AvailabilityResponseDto{}, err
 	}
 
+	// Resolve the participant when an organiser enters the availability on their behalf
+	accountId := user.Id
+	isProxy := data.ParticipantId != nil && *data.ParticipantId != user.Id
+	if isProxy {
+		if err := s.validateProxyAccess(&event, &user.Id, *data.ParticipantId); err != nil {
+			return AvailabilityResponseDto{}, err
+		}
+		accountId = *data.ParticipantId
+	}
+
 	data.StartsAt = data.StartsAt.Truncate(time.Minute)
 	data.EndsAt = data.EndsAt.Truncate(time.Minute)
 
@@ -102,8 +181,8 @@ func (s *AvailabilityService) Create(data *AvailabilityCreateDto, eventId uuid.U
 		return AvailabilityResponseDto{}, err
 	}
 
-	// Acquire per-event mutex to prevent concurrent availability modifications
-	value, _ := s.locks.LoadOrStore(user.Id.String(), &sync.Mutex{})
+	// Acquire per-user mutex to prevent concurrent availability modifications
+	value, _ := s.locks.LoadOrStore(accountId.String(), &sync.Mutex{})
 	mu := value.(*sync.Mutex)
 
 	mu.Lock()
@@ -114,24 +193,45 @@ func (s *AvailabilityService) Create(data *AvailabilityCreateDto, eventId uuid.U
 		Id:        uuid.New(),
 		StartsAt:  data.StartsAt,
 		EndsAt:    data.EndsAt,
-		AccountId: user.Id,
+		AccountId: accountId,
 		EventId:   eventId,
+		Note:      normalizeNote(data.Note),
+	}
+	if isProxy {
+		availabilityToCreate.ProxyAccountId = &user.Id
+	}
+	if err := s.mergeAndCreate(&availabilityToCreate); err != nil {
+		return AvailabilityResponseDto{}, err
+	}
+	s.publishChange(constants.DOMAIN_EVENT_AVAILABILITY_CREATED, availabilityToCreate, nil, domainevent.FieldsOf(availabilityToCreate), user)
+
+	if isProxy {
+		s.notifyProxyChange(findParticipantAccount(&event, accountId), event, user, constants.AVAILABILITY_CHANGE_CREATED, data.StartsAt, data.EndsAt)
 	}
 
+	// Trigger slot recalculation asynchronously
+	go s.slotService.LoadSlots(eventId)
+
+	return MapToAvailabilityResponseDto(availabilityToCreate), nil
+}
+
+// mergeAndCreate merges the availability with the overlapping ones of the same account and event, then creates it.
+// The caller must hold the per-user lock.
+func (s *AvailabilityService) mergeAndCreate(availabilityToCreate *model.Availability) error {
 	// Find overlapping availabilities
 	var availabilitiesToMerge []model.Availability
-	if err := s.availabilityRepository.FindOverlappingAvailabilities(&availabilityToCreate, &availabilitiesToMerge); err != nil {
-		return AvailabilityResponseDto{}, err
+	if err := s.availabilityRepository.FindOverlappingAvailabilities(availabilityToCreate, &availabilitiesToMerge); err != nil {
+		return err
 	}
 
 	if len(availabilitiesToMerge) == 0 {
 		// No overlapping availabilities, just create the new one
-		if err := s.availabilityRepository.Create(&availabilityToCreate); err != nil {
-			return AvailabilityResponseDto{}, err
-		}
-		return MapToAvailabilityResponseDto(availabilityToCreate), nil
+		return s.availabilityRepository.Create(availabilityToCreate)
 	}
 
+	// Combine notes before the ranges are widened
+	availabilityToCreate.Note = mergeNotes(append([]model.Availability{*availabilityToCreate}, availabilitiesToMerge...))
+
 	// Merge overlapping availabilities
 	var availabilitiesIdsToDelete []uuid.UUID
 	for _, existingAvailability := range availabilitiesToMerge {
@@ -141,24 +241,169 @@ func (s *AvailabilityService) Create(data *AvailabilityCreateDto, eventId uuid.U
 		if existingAvailability.EndsAt.After(availabilityToCreate.EndsAt) {
 			availabilityToCreate.EndsAt = existingAvailability.EndsAt
 		}
-
 		availabilitiesIdsToDelete = append(availabilitiesIdsToDelete, existingAvailability.Id)
 	}
 
 	// Delete merged availabilities
 	if err := s.availabilityRepository.DeleteByIds(&availabilitiesIdsToDelete); err != nil {
-		return AvailabilityResponseDto{}, err
+		return err
 	}
 
 	// Create the merged availability
-	if err := s.availabilityRepository.Create(&availabilityToCreate); err != nil {
-		return AvailabilityResponseDto{}, err
+	return s.availabilityRepository.Create(availabilityToCreate)
+}
+
+// publishChange publishes the change of the availability made by the user
+func (s *AvailabilityService) publishChange(eventType constants.DomainEventType, availability model.Availability, before, after map[string]any, user *guard.Claims) {
+	s.domainEventBus.Publish(domainevent.NewChange(eventType, availability.EventId, &user.Id, before, after).WithAccount(availability.AccountId))
+}
+
+// normalizeNote trims the note and returns nil when nothing is left
+func normalizeNote(note *string) *string {
+	if note == nil {
+		return nil
 	}
 
-	// Trigger slot recalculation asynchronously
-	go s.slotService.LoadSlots(eventId)
+	trimmed := strings.TrimSpace(*note)
+	if trimmed == "" {
+		return nil
+	}
 
-	return MapToAvailabilityResponseDto(availabilityToCreate), nil
+	return &trimmed
+}
+
+// mergeNotes combines the notes of availabilities being merged into one.
+// Notes are ordered by availability start time (then ID), duplicates are dropped case-insensitively,
+// and the result is joined with "; " and truncated to the maximum note length.
+func mergeNotes(availabilities []model.Availability) *string {
+	sorted := slices.Clone(availabilities)
+	slices.SortStableFunc(sorted, func(a, b model.Availability) int {
+		if c := a.StartsAt.Compare(b.StartsAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Id.String(), b.Id.String())
+	})
+
+	notes := make([]string, 0, len(sorted))
+	seen := make(map[string]bool, len(sorted))
+	for _, availability := range sorted {
+		note := normalizeNote(availability.Note)
+		if note == nil || seen[strings.ToLower(*note)] {
+			continue
+		}
+		seen[strings.ToLower(*note)] = true
+		notes = append(notes, *note)
+	}
+
+	if len(notes) == 0 {
+		return nil
+	}
+
+	merged := strings.Join(notes, noteSeparator)
+	if runes := []rune(merged); len(runes) > noteMaxLength {
+		merged = strings.TrimSpace(string(runes[:noteMaxLength]))
+	}
+
+	return &merged
+}
+
+// clipToEvent clips a time range to the event date range and snaps it inward on the 5 minutes grid.
+// Returns false when nothing usable is left.
+func clipToEvent(startsAt, endsAt time.Time, event *model.Event) (time.Time, time.Time, bool) {
+	if startsAt.Before(event.StartsAt) {
+		startsAt = event.StartsAt
+	}
+	if endsAt.After(event.EndsAt) {
+		endsAt = event.EndsAt
+	}
+
+	// Round start up and end down to stay inside the original range
+	if aligned := startsAt.Truncate(gridInterval); !aligned.Equal(startsAt) {
+		startsAt = aligned.Add(gridInterval)
+	}
+	endsAt = endsAt.Truncate(gridInterval)
+
+	if endsAt.Sub(startsAt) < gridInterval {
+		return startsAt, endsAt, false
+	}
+
+	return startsAt, endsAt, true
+}
+
+// CopyFromEvent copies the user availabilities of a source event into the target event
+func (s *AvailabilityService) CopyFromEvent(data *AvailabilityCopyDto, eventId uuid.UUID, user *guard.Claims) ([]AvailabilityResponseDto, error) {
+	if data.SourceEventId == eventId {
+		return nil, constants.ERR_AVAILABILITY_COPY_SAME_EVENT.Err
+	}
+
+	// Get target event and validate access
+	var event model.Event
+	if err := s.validateEventAccess(eventId, &user.Id, &event); err != nil {
+		return nil, err
+	}
+
+	// Get source event and check the user is a member
+	var sourceEvent model.Event
+	if err := s.eventRepository.FindOneById(data.SourceEventId, &sourceEvent); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return nil, err
+	}
+	if !sourceEvent.Can(&user.Id, constants.EVENT_PERMISSION_VIEW) {
+		return nil, constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+
+	var sourceAvailabilities []model.Availability
+	if err := s.availabilityRepository.FindByAccountAndEventId(user.Id, sourceEvent.Id, &sourceAvailabilities); err != nil {
+		return nil, err
+	}
+
+	// Acquire per-user mutex to prevent concurrent availability modifications
+	value, _ := s.locks.LoadOrStore(user.Id.String(), &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	defer mu.Unlock()
+
+	copiedCount := 0
+	for _, sourceAvailability := range sourceAvailabilities {
+		startsAt, endsAt, ok := clipToEvent(sourceAvailability.StartsAt, sourceAvailability.EndsAt, &event)
+		if !ok {
+			continue
+		}
+
+		availabilityToCreate := model.Availability{
+			Id:        uuid.New(),
+			StartsAt:  startsAt,
+			EndsAt:    endsAt,
+			AccountId: user.Id,
+			EventId:   eventId,
+			Note:      sourceAvailability.Note,
+		}
+		if err := s.mergeAndCreate(&availabilityToCreate); err != nil {
+			return nil, err
+		}
+		s.publishChange(constants.DOMAIN_EVENT_AVAILABILITY_CREATED, availabilityToCreate, nil, domainevent.FieldsOf(availabilityToCreate), user)
+		copiedCount++
+	}
+
+	if copiedCount > 0 {
+		// Trigger slot recalculation once every range is copied
+		go s.slotService.LoadSlots(eventId)
+	}
+
+	// Return the resulting availabilities of the user in the target event
+	var availabilities []model.Availability
+	if err := s.availabilityRepository.FindByAccountAndEventId(user.Id, eventId, &availabilities); err != nil {
+		return nil, err
+	}
+
+	result := make([]AvailabilityResponseDto, 0, len(availabilities))
+	for _, availability := range availabilities {
+		result = append(result, MapToAvailabilityResponseDto(availability))
+	}
+
+	return result, nil
 }
 
 func (s *AvailabilityService) Update(data *AvailabilityUpdateDto, availabilityId uuid.UUID, user *guard.Claims) (AvailabilityResponseDto, error) {
@@ -171,15 +416,22 @@ func (s *AvailabilityService) Update(data *AvailabilityUpdateDto, availabilityId
 		return AvailabilityResponseDto{}, err
 	}
 
-	// Check if availability belongs to the user
-	if availability.AccountId != user.Id {
-		return AvailabilityResponseDto{}, constants.ERR_AVAILABILITY_ACCESS_DENIED.Err
-	}
-
 	// Validate event access
 	if err := s.validateEventAccess(availability.Event.Id, &user.Id, &availability.Event); err != nil {
 		return AvailabilityResponseDto{}, err
 	}
+	before := domainevent.FieldsOf(availability)
+
+	// Check if availability belongs to the user or an organiser acts on behalf of the participant
+	isProxy := availability.AccountId != user.Id
+	if isProxy {
+		if err := s.validateProxyAccess(&availability.Event, &user.Id, availability.AccountId); err != nil {
+			return AvailabilityResponseDto{}, err
+		}
+		availability.ProxyAccountId = &user.Id
+	} else {
+		availability.ProxyAccountId = nil
+	}
 
 	// Update fields if provided
 	updated := false
@@ -191,9 +443,22 @@ func (s *AvailabilityService) Update(data *AvailabilityUpdateDto, availabilityId
 		availability.EndsAt = data.EndsAt.Truncate(time.Minute)
 		updated = true
 	}
+	if data.Note != nil {
+		availability.Note = normalizeNote(data.Note)
+	}
 
-	// If no fields were updated, return early
+	// If no time fields were updated, only persist the note
 	if !updated {
+		if data.Note == nil {
+			return MapToAvailabilityResponseDto(availability), nil
+		}
+		if err := s.availabilityRepository.Update(&availability); err != nil {
+			return AvailabilityResponseDto{}, err
+		}
+		s.publishChange(constants.DOMAIN_EVENT_AVAILABILITY_UPDATED, availability, before, domainevent.FieldsOf(availability), user)
+		if isProxy {
+			s.notifyProxyChange(availability.Account, availability.Event, user, constants.AVAILABILITY_CHANGE_UPDATED, availability.StartsAt, availability.EndsAt)
+		}
 		return MapToAvailabilityResponseDto(availability), nil
 	}
 
@@ -203,7 +468,7 @@ func (s *AvailabilityService) Update(data *AvailabilityUpdateDto, availabilityId
 	}
 
 	// Acquire per-user mutex to prevent concurrent availability modifications
-	value, _ := s.locks.LoadOrStore(user.Id.String(), &sync.Mutex{})
+	value, _ := s.locks.LoadOrStore(availability.AccountId.String(), &sync.Mutex{})
 	mu := value.(*sync.Mutex)
 
 	mu.Lock()
@@ -228,6 +493,11 @@ func (s *AvailabilityService) Update(data *AvailabilityUpdateDto, availabilityId
 		if err := s.availabilityRepository.Update(&availability); err != nil {
 			return AvailabilityResponseDto{}, err
 		}
+		s.publishChange(constants.DOMAIN_EVENT_AVAILABILITY_UPDATED, availability, before, domainevent.FieldsOf(availability), user)
+
+		if isProxy {
+			s.notifyProxyChange(availability.Account, availability.Event, user, constants.AVAILABILITY_CHANGE_UPDATED, availability.StartsAt, availability.EndsAt)
+		}
 
 		// Trigger slot recalculation asynchronously
 		go s.slotService.LoadSlots(availability.EventId)
@@ -235,6 +505,9 @@ func (s *AvailabilityService) Update(data *AvailabilityUpdateDto, availabilityId
 		return MapToAvailabilityResponseDto(availability), nil
 	}
 
+	// Combine notes before the ranges are widened
+	availability.Note = mergeNotes(append([]model.Availability{availability}, otherAvailabilities...))
+
 	// Merge overlapping availabilities
 	var availabilitiesIdsToDelete []uuid.UUID
 	for _, existingAvailability := range otherAvailabilities {
@@ -262,6 +535,11 @@ func (s *AvailabilityService) Update(data *AvailabilityUpdateDto, availabilityId
 	if err := s.availabilityRepository.Update(&availability); err != nil {
 		return AvailabilityResponseDto{}, err
 	}
+	s.publishChange(constants.DOMAIN_EVENT_AVAILABILITY_UPDATED, availability, before, domainevent.FieldsOf(availability), user)
+
+	if isProxy {
+		s.notifyProxyChange(availability.Account, availability.Event, user, constants.AVAILABILITY_CHANGE_UPDATED, availability.StartsAt, availability.EndsAt)
+	}
 
 	// Trigger slot recalculation asynchronously
 	go s.slotService.LoadSlots(availability.EventId)
@@ -279,22 +557,25 @@ func (s *AvailabilityService) Delete(availabilityId uuid.UUID, user *guard.Claim
 		return err
 	}
 
-	// Check if availability belongs to the user
-	if availability.AccountId != user.Id {
-		return constants.ERR_AVAILABILITY_ACCESS_DENIED.Err
+	// Check if availability belongs to the user or an organiser acts on behalf of the participant
+	isProxy := availability.AccountId != user.Id
+	if isProxy {
+		if err := s.validateProxyAccess(&availability.Event, &user.Id, availability.AccountId); err != nil {
+			return err
+		}
 	}
 
-	// Check if user has access to the event
-	if !availability.Event.HasUserAccess(&user.Id) {
+	// Check if user can submit availabilities, viewers are read-only
+	if !availability.Event.Can(&user.Id, constants.EVENT_PERMISSION_CONTRIBUTE) {
 		return constants.ERR_EVENT_ACCESS_DENIED.Err
 	}
 
 	// Check if event is ended
-	if hasStatus, err := availability.Event.CheckAndAutoUpdateStatus(s.eventRepository.Updates, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION}); !hasStatus || err != nil {
+	if hasStatus, err := availability.Event.CheckAndAutoUpdateStatus(s.lifecycleService.Finish, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION}); !hasStatus || err != nil {
 		if err != nil {
 			return err
 		}
-		return constants.ERR_EVENT_ENDED.Err
+		return availability.Event.LockedError()
 	}
 
 	// Delete availability
@@ -304,9 +585,186 @@ func (s *AvailabilityService) Delete(availabilityId uuid.UUID, user *guard.Claim
 		}
 		return err
 	}
+	s.publishChange(constants.DOMAIN_EVENT_AVAILABILITY_DELETED, availability, domainevent.FieldsOf(availability), nil, user)
+
+	if isProxy {
+		s.notifyProxyChange(availability.Account, availability.Event, user, constants.AVAILABILITY_CHANGE_DELETED, availability.StartsAt, availability.EndsAt)
+	}
 
 	// Trigger slot recalculation asynchronously
 	go s.slotService.LoadSlots(availability.EventId)
 
 	return nil
 }
+
+// Export returns the availability matrix of the event to its members, its buckets are computed as they are read
+func (s *AvailabilityService) Export(eventId uuid.UUID, user *guard.Claims) (*AvailabilityMatrix, error) {
+	var event model.Event
+	if err := s.eventRepository.FindOneById(eventId, &event); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return nil, err
+	}
+	if _, isMember := event.RoleOf(&user.Id); !isMember {
+		return nil, constants.ERR_EVENT_NOT_FOUND.Err
+	}
+
+	return buildMatrix(&event)
+}
+
+// AvailabilityMatrix is the availability matrix of an event. It holds everything but the buckets,
+// which EachBucket computes one at a time so that an export does not keep them all in memory.
+type AvailabilityMatrix struct {
+	AvailabilityMatrixDto
+	event          *model.Event
+	availabilities map[uuid.UUID][]model.Availability
+	spanStartsAt   time.Time
+	spanEndsAt     time.Time
+}
+
+// buildMatrix prepares the matrix of the event: the participants are the members who can submit availabilities,
+// in the order they joined. Only the part of the event covered by availabilities or slots is split, and it cannot
+// exceed maxExportSpan.
+func buildMatrix(event *model.Event) (*AvailabilityMatrix, error) {
+	spanStartsAt, spanEndsAt := matrixSpan(event)
+	if spanEndsAt.Sub(spanStartsAt) > maxExportSpan {
+		return nil, constants.ERR_AVAILABILITY_EXPORT_TOO_LARGE.Err
+	}
+
+	accountEvents := slices.Clone(event.AccountEvents)
+	slices.SortStableFunc(accountEvents, func(a, b model.AccountEvent) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.AccountId.String(), b.AccountId.String())
+	})
+
+	participants := []AvailabilityMatrixParticipantDto{}
+	for _, accountEvent := range accountEvents {
+		if !event.Can(&accountEvent.AccountId, constants.EVENT_PERMISSION_CONTRIBUTE) {
+			continue
+		}
+		participants = append(participants, AvailabilityMatrixParticipantDto{
+			Id:       accountEvent.AccountId,
+			UserName: accountEvent.Account.UserName,
+		})
+	}
+
+	availabilities := make(map[uuid.UUID][]model.Availability, len(participants))
+	for _, availability := range event.Availabilities {
+		availabilities[availability.AccountId] = append(availabilities[availability.AccountId], availability)
+	}
+
+	slots := make([]slot.SlotResponseDto, 0, len(event.Slots))
+	for _, eventSlot := range event.Slots {
+		slots = append(slots, slot.MapToSlotResponseDto(eventSlot))
+	}
+	slices.SortFunc(slots, func(a, b slot.SlotResponseDto) int { return a.StartsAt.Compare(b.StartsAt) })
+
+	return &AvailabilityMatrix{
+		AvailabilityMatrixDto: AvailabilityMatrixDto{
+			EventId:      event.Id,
+			Resolution:   int(gridInterval / time.Minute),
+			Participants: participants,
+			Slots:        slots,
+		},
+		event:          event,
+		availabilities: availabilities,
+		spanStartsAt:   spanStartsAt,
+		spanEndsAt:     spanEndsAt,
+	}, nil
+}
+
+// EachBucket splits the span of the matrix on the availability grid and passes each bucket to write as soon as it is
+// computed, telling which participants are available for the whole bucket and whether it belongs to a computed slot
+// or to the validated slot. It stops at the first error returned by write.
+func (m *AvailabilityMatrix) EachBucket(write func(bucket AvailabilityMatrixBucketDto) error) error {
+	covers := func(startsAt, endsAt, bucketStartsAt, bucketEndsAt time.Time) bool {
+		return !startsAt.After(bucketStartsAt) && !endsAt.Before(bucketEndsAt)
+	}
+
+	for at := m.spanStartsAt.UTC().Truncate(gridInterval); at.Before(m.spanEndsAt); at = at.Add(gridInterval) {
+		// The buckets cover the span rounded out to the grid, the ones crossing the bounds of the event are clipped to it
+		bucket := AvailabilityMatrixBucketDto{
+			StartsAt:  at,
+			EndsAt:    at.Add(gridInterval),
+			Available: make([]bool, len(m.Participants)),
+		}
+		if bucket.StartsAt.Before(m.event.StartsAt) {
+			bucket.StartsAt = m.event.StartsAt.UTC()
+		}
+		if bucket.EndsAt.After(m.event.EndsAt) {
+			bucket.EndsAt = m.event.EndsAt.UTC()
+		}
+
+		for i, participant := range m.Participants {
+			for _, availability := range m.availabilities[participant.Id] {
+				if covers(availability.StartsAt, availability.EndsAt, bucket.StartsAt, bucket.EndsAt) {
+					bucket.Available[i] = true
+					bucket.AvailableCount++
+					break
+				}
+			}
+		}
+
+		for _, eventSlot := range m.event.Slots {
+			if !covers(eventSlot.StartsAt, eventSlot.EndsAt, bucket.StartsAt, bucket.EndsAt) {
+				continue
+			}
+			if eventSlot.IsValidated {
+				bucket.IsValidatedSlot = true
+			} else {
+				bucket.IsComputedSlot = true
+			}
+		}
+
+		if err := write(bucket); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Dto returns the whole matrix with its buckets, for the JSON export
+func (m *AvailabilityMatrix) Dto() AvailabilityMatrixDto {
+	dto := m.AvailabilityMatrixDto
+	dto.Buckets = []AvailabilityMatrixBucketDto{}
+	_ = m.EachBucket(func(bucket AvailabilityMatrixBucketDto) error {
+		dto.Buckets = append(dto.Buckets, bucket)
+		return nil
+	})
+
+	return dto
+}
+
+// matrixSpan returns the part of the event covered by its availabilities and slots, empty when there is none
+func matrixSpan(event *model.Event) (time.Time, time.Time) {
+	var startsAt, endsAt time.Time
+	extend := func(periodStartsAt, periodEndsAt time.Time) {
+		if startsAt.IsZero() || periodStartsAt.Before(startsAt) {
+			startsAt = periodStartsAt
+		}
+		if periodEndsAt.After(endsAt) {
+			endsAt = periodEndsAt
+		}
+	}
+	for _, availability := range event.Availabilities {
+		extend(availability.StartsAt, availability.EndsAt)
+	}
+	for _, eventSlot := range event.Slots {
+		extend(eventSlot.StartsAt, eventSlot.EndsAt)
+	}
+	if startsAt.IsZero() {
+		return event.StartsAt, event.StartsAt
+	}
+
+	if startsAt.Before(event.StartsAt) {
+		startsAt = event.StartsAt
+	}
+	if endsAt.After(event.EndsAt) {
+		endsAt = event.EndsAt
+	}
+	return startsAt, endsAt
+}
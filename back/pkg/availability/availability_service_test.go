@@ -3,12 +3,16 @@ package availability
 import (
 	"app/commons/constants"
 	model "app/db/models"
+	"bytes"
+	"encoding/csv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // NOTE: Do not initialize real services at package init time in unit tests.
@@ -222,3 +226,295 @@ func TestValidateAvailabilityTimes_ValidTimes(t *testing.T) {
 	err := service.validateAvailabilityTimes(startsAt, endsAt, &event)
 	assert.NoError(t, err, "Expected no error for valid times")
 }
+
+// TestClipToEvent_InsideEvent tests that a range inside the event is kept unchanged
+func TestClipToEvent_InsideEvent(t *testing.T) {
+	event := createMockEvent()
+	startsAt := alignToFiveMinutes(event.StartsAt.Add(1 * time.Hour))
+	endsAt := startsAt.Add(2 * time.Hour)
+
+	clippedStart, clippedEnd, ok := clipToEvent(startsAt, endsAt, &event)
+
+	assert.True(t, ok, "Expected range inside event to be kept")
+	assert.Equal(t, startsAt, clippedStart, "Start should be unchanged")
+	assert.Equal(t, endsAt, clippedEnd, "End should be unchanged")
+}
+
+// TestClipToEvent_OverlapsEventBounds tests that a range is clipped to the event bounds and snapped on the grid
+func TestClipToEvent_OverlapsEventBounds(t *testing.T) {
+	event := model.Event{
+		Id:       uuid.New(),
+		StartsAt: time.Date(2024, 1, 1, 9, 2, 0, 0, time.UTC),
+		EndsAt:   time.Date(2024, 1, 1, 17, 58, 0, 0, time.UTC),
+	}
+
+	clippedStart, clippedEnd, ok := clipToEvent(
+		time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 19, 0, 0, 0, time.UTC),
+		&event,
+	)
+
+	assert.True(t, ok, "Expected overlapping range to be kept")
+	assert.Equal(t, time.Date(2024, 1, 1, 9, 5, 0, 0, time.UTC), clippedStart, "Start should be rounded up to 09:05")
+	assert.Equal(t, time.Date(2024, 1, 1, 17, 55, 0, 0, time.UTC), clippedEnd, "End should be rounded down to 17:55")
+}
+
+// TestClipToEvent_OutsideEvent tests that a range outside the event is dropped
+func TestClipToEvent_OutsideEvent(t *testing.T) {
+	event := createMockEvent()
+	startsAt := alignToFiveMinutes(event.EndsAt.Add(1 * time.Hour))
+	endsAt := startsAt.Add(1 * time.Hour)
+
+	_, _, ok := clipToEvent(startsAt, endsAt, &event)
+
+	assert.False(t, ok, "Expected range outside event to be dropped")
+}
+
+// TestClipToEvent_TooShortAfterClip tests that a range shorter than the grid after clipping is dropped
+func TestClipToEvent_TooShortAfterClip(t *testing.T) {
+	event := model.Event{
+		Id:       uuid.New(),
+		StartsAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+	}
+
+	_, _, ok := clipToEvent(
+		time.Date(2024, 1, 1, 10, 1, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 10, 9, 0, 0, time.UTC),
+		&event,
+	)
+
+	assert.False(t, ok, "Expected range shorter than 5 minutes after snapping to be dropped")
+}
+
+// TestNormalizeNote tests that notes are trimmed and blank notes are removed
+func TestNormalizeNote(t *testing.T) {
+	assert.Nil(t, normalizeNote(nil), "Nil note should stay nil")
+
+	blank := "   "
+	assert.Nil(t, normalizeNote(&blank), "Blank note should be removed")
+
+	note := "  remote only "
+	result := normalizeNote(&note)
+	assert.NotNil(t, result, "Note should be kept")
+	assert.Equal(t, "remote only", *result, "Note should be trimmed")
+}
+
+// TestMergeNotes_OrderedByStartTime tests that notes are combined in chronological order
+func TestMergeNotes_OrderedByStartTime(t *testing.T) {
+	late := "can leave at 17:30"
+	early := "remote only"
+
+	result := mergeNotes([]model.Availability{
+		{Id: uuid.New(), StartsAt: time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC), Note: &late},
+		{Id: uuid.New(), StartsAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), Note: &early},
+		{Id: uuid.New(), StartsAt: time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)},
+	})
+
+	assert.NotNil(t, result, "Merged note should not be nil")
+	assert.Equal(t, "remote only; can leave at 17:30", *result, "Notes should be joined by start time")
+}
+
+// TestMergeNotes_Deduplicates tests that identical notes are kept once
+func TestMergeNotes_Deduplicates(t *testing.T) {
+	first := "Remote only"
+	second := "remote only "
+
+	result := mergeNotes([]model.Availability{
+		{Id: uuid.New(), StartsAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), Note: &first},
+		{Id: uuid.New(), StartsAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), Note: &second},
+	})
+
+	assert.NotNil(t, result, "Merged note should not be nil")
+	assert.Equal(t, "Remote only", *result, "Duplicate notes should be dropped")
+}
+
+// TestMergeNotes_NoNotes tests that merging availabilities without notes gives no note
+func TestMergeNotes_NoNotes(t *testing.T) {
+	result := mergeNotes([]model.Availability{
+		{Id: uuid.New(), StartsAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)},
+		{Id: uuid.New(), StartsAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+	})
+
+	assert.Nil(t, result, "Merged note should be nil")
+}
+
+// TestMergeNotes_Truncated tests that the merged note never exceeds the maximum length
+func TestMergeNotes_Truncated(t *testing.T) {
+	first := strings.Repeat("a", 200)
+	second := strings.Repeat("b", 200)
+
+	result := mergeNotes([]model.Availability{
+		{Id: uuid.New(), StartsAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), Note: &first},
+		{Id: uuid.New(), StartsAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), Note: &second},
+	})
+
+	assert.NotNil(t, result, "Merged note should not be nil")
+	assert.Len(t, []rune(*result), noteMaxLength, "Merged note should be truncated")
+}
+
+func TestValidateProxyAccess(t *testing.T) {
+	service := &AvailabilityService{}
+	ownerId := uuid.New()
+	participantId := uuid.New()
+	event := createMockEvent()
+	event.OwnerId = ownerId
+	event.AccountEvents = []model.AccountEvent{
+		{AccountId: ownerId, EventId: event.Id},
+		{AccountId: participantId, EventId: event.Id},
+	}
+
+	t.Run("owner can act for a participant", func(t *testing.T) {
+		assert.NoError(t, service.validateProxyAccess(&event, &ownerId, participantId))
+	})
+
+	t.Run("non owner is denied", func(t *testing.T) {
+		err := service.validateProxyAccess(&event, &participantId, ownerId)
+		assert.Equal(t, constants.ERR_AVAILABILITY_ACCESS_DENIED.Err, err)
+	})
+
+	t.Run("unknown participant is rejected", func(t *testing.T) {
+		err := service.validateProxyAccess(&event, &ownerId, uuid.New())
+		assert.Equal(t, constants.ERR_EVENT_PARTICIPANT_NOT_FOUND.Err, err)
+	})
+
+	organiserId := uuid.New()
+	viewerId := uuid.New()
+	event.AccountEvents = append(event.AccountEvents,
+		model.AccountEvent{AccountId: organiserId, EventId: event.Id, Role: constants.EVENT_ROLE_ORGANISER},
+		model.AccountEvent{AccountId: viewerId, EventId: event.Id, Role: constants.EVENT_ROLE_VIEWER},
+	)
+
+	t.Run("organiser can act for a participant", func(t *testing.T) {
+		assert.NoError(t, service.validateProxyAccess(&event, &organiserId, participantId))
+	})
+
+	t.Run("viewer has no availability to manage", func(t *testing.T) {
+		err := service.validateProxyAccess(&event, &ownerId, viewerId)
+		assert.Equal(t, constants.ERR_EVENT_PARTICIPANT_NOT_FOUND.Err, err)
+	})
+}
+
+func TestFindParticipantAccount(t *testing.T) {
+	participantId := uuid.New()
+	email := "participant@example.com"
+	event := createMockEvent()
+	event.AccountEvents = []model.AccountEvent{
+		{AccountId: participantId, Account: model.Account{Id: participantId, Email: &email}},
+	}
+
+	account := findParticipantAccount(&event, participantId)
+	assert.Equal(t, &email, account.Email)
+
+	unknownId := uuid.New()
+	account = findParticipantAccount(&event, unknownId)
+	assert.Equal(t, unknownId, account.Id)
+	assert.Nil(t, account.Email)
+}
+
+func TestBuildMatrix(t *testing.T) {
+	startsAt := time.Date(2026, 3, 2, 9, 2, 0, 0, time.UTC)
+	ownerId := uuid.New()
+	participantId := uuid.New()
+	viewerId := uuid.New()
+	ownerName := "alice"
+	event := model.Event{
+		Id:       uuid.New(),
+		OwnerId:  ownerId,
+		StartsAt: startsAt,
+		EndsAt:   startsAt.Add(20 * time.Minute),
+		AccountEvents: []model.AccountEvent{
+			{AccountId: participantId, Role: constants.EVENT_ROLE_PARTICIPANT, CreatedAt: startsAt.Add(-time.Hour)},
+			{AccountId: viewerId, Role: constants.EVENT_ROLE_VIEWER, CreatedAt: startsAt.Add(-time.Hour)},
+			{AccountId: ownerId, Role: constants.EVENT_ROLE_OWNER, CreatedAt: startsAt.Add(-2 * time.Hour), Account: model.Account{UserName: &ownerName}},
+		},
+		Availabilities: []model.Availability{
+			{AccountId: ownerId, StartsAt: startsAt.Add(3 * time.Minute), EndsAt: startsAt.Add(13 * time.Minute)},
+			{AccountId: participantId, StartsAt: startsAt.Add(8 * time.Minute), EndsAt: startsAt.Add(18 * time.Minute)},
+		},
+		Slots: []model.Slot{
+			{StartsAt: startsAt.Add(8 * time.Minute), EndsAt: startsAt.Add(13 * time.Minute), IsValidated: true},
+		},
+	}
+
+	built, err := buildMatrix(&event)
+	require.NoError(t, err)
+	matrix := built.Dto()
+
+	assert.Equal(t, 5, matrix.Resolution)
+	assert.Len(t, matrix.Participants, 2, "viewers cannot submit availabilities")
+	assert.Equal(t, ownerId, matrix.Participants[0].Id, "participants are ordered by join date")
+	assert.Equal(t, participantId, matrix.Participants[1].Id)
+
+	// Only the span of the availabilities is split: 9:05-9:10, 9:10-9:15, 9:15-9:20
+	assert.Len(t, matrix.Buckets, 3)
+	assert.Equal(t, startsAt.Add(3*time.Minute), matrix.Buckets[0].StartsAt)
+	assert.Equal(t, startsAt.Add(18*time.Minute), matrix.Buckets[2].EndsAt)
+	assert.Equal(t, []bool{true, false}, matrix.Buckets[0].Available)
+	assert.Equal(t, []bool{true, true}, matrix.Buckets[1].Available)
+	assert.Equal(t, 2, matrix.Buckets[1].AvailableCount)
+	assert.True(t, matrix.Buckets[1].IsValidatedSlot)
+	assert.False(t, matrix.Buckets[1].IsComputedSlot)
+	assert.Equal(t, []bool{false, true}, matrix.Buckets[2].Available)
+	assert.False(t, matrix.Buckets[2].IsValidatedSlot)
+	assert.Len(t, matrix.Slots, 1)
+}
+
+func TestBuildMatrix_LimitsTheSpan(t *testing.T) {
+	startsAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ownerId := uuid.New()
+	event := model.Event{
+		Id:            uuid.New(),
+		OwnerId:       ownerId,
+		StartsAt:      startsAt,
+		EndsAt:        startsAt.AddDate(1, 0, 0),
+		AccountEvents: []model.AccountEvent{{AccountId: ownerId, Role: constants.EVENT_ROLE_OWNER}},
+	}
+
+	matrix, err := buildMatrix(&event)
+	assert.NoError(t, err)
+	assert.Empty(t, matrix.Dto().Buckets, "an event without availabilities has no rows")
+
+	event.Availabilities = []model.Availability{{AccountId: ownerId, StartsAt: startsAt.AddDate(0, 6, 0), EndsAt: startsAt.AddDate(0, 6, 0).Add(time.Hour)}}
+	matrix, err = buildMatrix(&event)
+	assert.NoError(t, err)
+	assert.Len(t, matrix.Dto().Buckets, 12, "only the hour with availabilities is split")
+
+	event.Availabilities = append(event.Availabilities, model.Availability{AccountId: ownerId, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)})
+	_, err = buildMatrix(&event)
+	assert.Equal(t, constants.ERR_AVAILABILITY_EXPORT_TOO_LARGE.Err, err)
+}
+
+func TestWriteAvailabilityMatrixCsv(t *testing.T) {
+	startsAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	userName := "=HYPERLINK(\"x\")"
+	ownerId := uuid.New()
+	participantId := uuid.New()
+	event := model.Event{
+		Id:       uuid.New(),
+		OwnerId:  ownerId,
+		StartsAt: startsAt,
+		EndsAt:   startsAt.Add(time.Hour),
+		AccountEvents: []model.AccountEvent{
+			{AccountId: ownerId, Role: constants.EVENT_ROLE_OWNER, CreatedAt: startsAt.Add(-2 * time.Hour), Account: model.Account{UserName: &userName}},
+			{AccountId: participantId, Role: constants.EVENT_ROLE_PARTICIPANT, CreatedAt: startsAt.Add(-time.Hour)},
+		},
+		Availabilities: []model.Availability{
+			{AccountId: ownerId, StartsAt: startsAt, EndsAt: startsAt.Add(10 * time.Minute)},
+			{AccountId: participantId, StartsAt: startsAt, EndsAt: startsAt.Add(5 * time.Minute)},
+		},
+		Slots: []model.Slot{{StartsAt: startsAt, EndsAt: startsAt.Add(5 * time.Minute)}},
+	}
+	matrix, err := buildMatrix(&event)
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+	assert.NoError(t, WriteAvailabilityMatrixCsv(csv.NewWriter(&output), matrix))
+	records, err := csv.NewReader(&output).ReadAll()
+	assert.NoError(t, err)
+
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"startsAt", "endsAt", "'" + userName, participantId.String(), "available", "slot"}, records[0])
+	assert.Equal(t, []string{"2026-03-02T09:00:00Z", "2026-03-02T09:05:00Z", "1", "1", "2", "computed"}, records[1])
+	assert.Equal(t, []string{"2026-03-02T09:05:00Z", "2026-03-02T09:10:00Z", "1", "0", "1", ""}, records[2])
+}
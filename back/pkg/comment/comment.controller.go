@@ -0,0 +1,202 @@
+package comment
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	"app/commons/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CommentController struct {
+	commentService *CommentService
+}
+
+func NewCommentController(ctl *CommentController) *CommentController {
+	if ctl != nil {
+		return ctl
+	}
+
+	return &CommentController{
+		commentService: NewCommentService(nil),
+	}
+}
+
+// @Summary Get the discussion thread of an event
+// @Description Lists the comments of the event, the newest first. Deleted comments are kept without their body.
+// @Description Comments created, edited and deleted are also sent on the SSE connection of the event as comment frames.
+// @Description In cursor mode the total is not returned, and the next page is requested with the nextCursor of the response until it is missing.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param cursor query string false "Cursor mode: empty for the first page, then the nextCursor of the previous page"
+// @Security BearerAuth
+// @Success 200 {object} CommentListResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_INVALID_PAGINATION_PARAMS, or ERR_INVALID_PAGINATION_CURSOR"
+// @Router /api/v1/events/{eventId}/comments [get]
+func (ctl *CommentController) List(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	var result CommentListResponseDto
+	if err := result.ParseCursorQuery(c); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	err = ctl.commentService.List(eventId, user, &result)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary Post a comment on an event
+// @Description Members are mentioned with @ followed by their username: they receive the comment on their SSE connection as a comment-mention frame and by email.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param data body CommentCreateDto true "Comment parameters"
+// @Security BearerAuth
+// @Success 200 {object} CommentResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_COMMENT_EMPTY"
+// @Router /api/v1/events/{eventId}/comments [post]
+func (ctl *CommentController) Create(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	var data CommentCreateDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	eventId, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	comment, err := ctl.commentService.Create(eventId, &data, user)
+	helpers.HandleJSONResponse(c, comment, err)
+}
+
+// @Summary Edit a comment
+// @Description Only the author can edit a comment. Only the members mentioned for the first time are notified.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param commentId path string true "Comment Id"
+// @Param data body CommentUpdateDto true "Comment parameters"
+// @Security BearerAuth
+// @Success 200 {object} CommentResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_COMMENT_NOT_FOUND, ERR_COMMENT_ACCESS_DENIED, or ERR_COMMENT_EMPTY"
+// @Router /api/v1/events/{eventId}/comments/{commentId} [patch]
+func (ctl *CommentController) Update(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	var data CommentUpdateDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	eventId, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	commentId, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_COMMENT_NOT_FOUND.Err)
+		return
+	}
+
+	comment, err := ctl.commentService.Update(eventId, commentId, &data, user)
+	helpers.HandleJSONResponse(c, comment, err)
+}
+
+// @Summary Delete a comment
+// @Description The author can delete their comments, and the owner of the event can delete any comment to moderate the thread. The comment stays in the thread without its body.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param commentId path string true "Comment Id"
+// @Security BearerAuth
+// @Success 200
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_COMMENT_NOT_FOUND, or ERR_COMMENT_ACCESS_DENIED"
+// @Router /api/v1/events/{eventId}/comments/{commentId} [delete]
+func (ctl *CommentController) Delete(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	commentId, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_COMMENT_NOT_FOUND.Err)
+		return
+	}
+
+	err = ctl.commentService.Delete(eventId, commentId, user)
+	helpers.HandleJSONResponse(c, nil, err)
+}
+
+// @Summary Subscribe to the comment digest of an event
+// @Description The subscribed members receive once a day an email with the comments posted by the other members since the previous digest.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param data body CommentDigestDto true "Digest parameters"
+// @Security BearerAuth
+// @Success 200
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND"
+// @Router /api/v1/events/{eventId}/comments/digest [put]
+func (ctl *CommentController) SetDigest(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	var data CommentDigestDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	eventId, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	err = ctl.commentService.SetDigest(eventId, &data, user)
+	helpers.HandleJSONResponse(c, nil, err)
+}
@@ -0,0 +1,17 @@
+package comment
+
+// CommentCreateDto - POST /events/:id/comments
+type CommentCreateDto struct {
+	// Members are mentioned with @ followed by their username
+	Body string `json:"body" binding:"required,max=2000"`
+}
+
+// CommentUpdateDto - PATCH /events/:id/comments/:commentId
+type CommentUpdateDto struct {
+	Body string `json:"body" binding:"required,max=2000"`
+}
+
+// CommentDigestDto - PUT /events/:id/comments/digest
+type CommentDigestDto struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
@@ -0,0 +1,26 @@
+package comment
+
+import model "app/db/models"
+
+func MapToCommentResponseDto(c model.Comment) CommentResponseDto {
+	dto := CommentResponseDto{
+		Id: c.Id,
+		Author: CommentAuthorDto{
+			Id:        c.AuthorId,
+			UserName:  c.Author.UserName,
+			AvatarUrl: c.Author.AvatarUrl,
+		},
+		CreatedAt: c.CreatedAt,
+		EditedAt:  c.EditedAt,
+		DeletedAt: c.DeletedAt,
+	}
+
+	if c.DeletedAt == nil {
+		body := c.Body
+		dto.Body = &body
+	} else {
+		dto.RemovedByModerator = c.DeletedById != nil && *c.DeletedById != c.AuthorId
+	}
+
+	return dto
+}
@@ -0,0 +1,40 @@
+package comment
+
+import (
+	"app/commons/constants"
+	"app/commons/lib"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CommentAuthorDto - member who wrote the comment
+type CommentAuthorDto struct {
+	Id        uuid.UUID `json:"id"`
+	UserName  *string   `json:"userName"`
+	AvatarUrl string    `json:"avatarUrl"`
+}
+
+// CommentResponseDto - comment of the thread, also the SSE comment-mention payload
+type CommentResponseDto struct {
+	Id     uuid.UUID        `json:"id"`
+	Author CommentAuthorDto `json:"author"`
+	// Nil once the comment is deleted
+	Body      *string    `json:"body"`
+	CreatedAt time.Time  `json:"createdAt"`
+	EditedAt  *time.Time `json:"editedAt"`
+	DeletedAt *time.Time `json:"deletedAt"`
+	// Whether the comment was deleted by the owner of the event rather than by its author
+	RemovedByModerator bool `json:"removedByModerator"`
+}
+
+// CommentEventDto - SSE comment payload
+type CommentEventDto struct {
+	Change  constants.CommentChange `json:"change"`
+	Comment CommentResponseDto      `json:"comment"`
+}
+
+// CommentListResponseDto - GET /events/:id/comments (paginated, newest comments first)
+type CommentListResponseDto struct {
+	lib.Pagination[CommentResponseDto]
+}
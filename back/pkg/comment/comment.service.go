@@ -0,0 +1,288 @@
+package comment
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	"app/commons/lib"
+	model "app/db/models"
+	"app/db/repository"
+	"app/pkg/mail"
+	"app/pkg/sse"
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CommentService handles the discussion thread of the events
+type CommentService struct {
+	commentRepository      *repository.CommentRepository
+	eventRepository        *repository.EventRepository
+	accountEventRepository *repository.AccountEventRepository
+	sseService             *sse.SSEService
+	mailService            *mail.MailService
+}
+
+func NewCommentService(service *CommentService) *CommentService {
+	if service != nil {
+		return service
+	}
+
+	return &CommentService{
+		commentRepository:      repository.NewCommentRepository(nil),
+		eventRepository:        repository.NewEventRepository(nil),
+		accountEventRepository: repository.NewAccountEventRepository(nil),
+		sseService:             sse.GetSSEService(),
+		mailService:            mail.NewMailService(nil),
+	}
+}
+
+// findEventOfMember returns the event when the user is one of its members
+func (s *CommentService) findEventOfMember(eventId uuid.UUID, userId uuid.UUID, event *model.Event) error {
+	if err := s.eventRepository.FindOneById(eventId, event); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return err
+	}
+	if _, isMember := event.RoleOf(&userId); !isMember {
+		return constants.ERR_EVENT_NOT_FOUND.Err
+	}
+
+	return nil
+}
+
+func (s *CommentService) findComment(commentId uuid.UUID, eventId uuid.UUID, comment *model.Comment) error {
+	if err := s.commentRepository.FindOneById(commentId, eventId, comment); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_COMMENT_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	return nil
+}
+
+// List returns a page of the thread of the event, the newest comments first.
+// In cursor mode the page starts after the cursor and the comments are not counted.
+func (s *CommentService) List(eventId uuid.UUID, user *guard.Claims, result *CommentListResponseDto) error {
+	var event model.Event
+	if err := s.findEventOfMember(eventId, user.Id, &event); err != nil {
+		return err
+	}
+
+	comments, err := lib.LoadPage(
+		&result.Pagination,
+		func() (int64, error) { return s.commentRepository.CountByEventId(eventId) },
+		func(after *repository.CreatedAtCursor, limit int, offset int) ([]model.Comment, error) {
+			return s.commentRepository.FindByEventId(eventId, after, limit, offset)
+		},
+		func(comment model.Comment) repository.CreatedAtCursor {
+			return repository.NewCreatedAtCursor(comment.Id, comment.CreatedAt)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	result.Data = make([]CommentResponseDto, 0, len(comments))
+	for _, comment := range comments {
+		result.Data = append(result.Data, MapToCommentResponseDto(comment))
+	}
+
+	return nil
+}
+
+// Create posts a comment in the thread of the event, streams it to the connected members
+// and notifies the members mentioned in it
+func (s *CommentService) Create(eventId uuid.UUID, data *CommentCreateDto, user *guard.Claims) (CommentResponseDto, error) {
+	body := strings.TrimSpace(data.Body)
+	if body == "" {
+		return CommentResponseDto{}, constants.ERR_COMMENT_EMPTY.Err
+	}
+
+	var event model.Event
+	if err := s.findEventOfMember(eventId, user.Id, &event); err != nil {
+		return CommentResponseDto{}, err
+	}
+
+	comment := model.Comment{
+		Id:        uuid.New(),
+		EventId:   eventId,
+		AuthorId:  user.Id,
+		Body:      body,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.commentRepository.Create(&comment); err != nil {
+		return CommentResponseDto{}, err
+	}
+	if err := s.findComment(comment.Id, eventId, &comment); err != nil {
+		return CommentResponseDto{}, err
+	}
+
+	response := MapToCommentResponseDto(comment)
+	s.sseService.Broadcast(eventId, constants.SSE_EVENT_COMMENT, CommentEventDto{Change: constants.COMMENT_CHANGE_CREATED, Comment: response})
+	s.notifyMentions(event, comment, mentionedMembers(body, event, user.Id), response)
+
+	return response, nil
+}
+
+// Update replaces the body of a comment. Only its author can edit it, and only the members mentioned for the first time are notified.
+func (s *CommentService) Update(eventId uuid.UUID, commentId uuid.UUID, data *CommentUpdateDto, user *guard.Claims) (CommentResponseDto, error) {
+	body := strings.TrimSpace(data.Body)
+	if body == "" {
+		return CommentResponseDto{}, constants.ERR_COMMENT_EMPTY.Err
+	}
+
+	var event model.Event
+	if err := s.findEventOfMember(eventId, user.Id, &event); err != nil {
+		return CommentResponseDto{}, err
+	}
+
+	var comment model.Comment
+	if err := s.findComment(commentId, eventId, &comment); err != nil {
+		return CommentResponseDto{}, err
+	}
+	if comment.DeletedAt != nil {
+		return CommentResponseDto{}, constants.ERR_COMMENT_NOT_FOUND.Err
+	}
+	if comment.AuthorId != user.Id {
+		return CommentResponseDto{}, constants.ERR_COMMENT_ACCESS_DENIED.Err
+	}
+
+	alreadyMentioned := make(map[uuid.UUID]bool)
+	for _, account := range mentionedMembers(comment.Body, event, user.Id) {
+		alreadyMentioned[account.Id] = true
+	}
+
+	if err := s.commentRepository.UpdateBody(commentId, body, time.Now().UTC()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return CommentResponseDto{}, constants.ERR_COMMENT_NOT_FOUND.Err
+		}
+		return CommentResponseDto{}, err
+	}
+	if err := s.findComment(commentId, eventId, &comment); err != nil {
+		return CommentResponseDto{}, err
+	}
+
+	newlyMentioned := []model.Account{}
+	for _, account := range mentionedMembers(body, event, user.Id) {
+		if !alreadyMentioned[account.Id] {
+			newlyMentioned = append(newlyMentioned, account)
+		}
+	}
+
+	response := MapToCommentResponseDto(comment)
+	s.sseService.Broadcast(eventId, constants.SSE_EVENT_COMMENT, CommentEventDto{Change: constants.COMMENT_CHANGE_UPDATED, Comment: response})
+	s.notifyMentions(event, comment, newlyMentioned, response)
+
+	return response, nil
+}
+
+// Delete removes a comment from the thread. The author can delete their comments, and the owner of the event can delete any comment to moderate the thread.
+func (s *CommentService) Delete(eventId uuid.UUID, commentId uuid.UUID, user *guard.Claims) error {
+	var event model.Event
+	if err := s.findEventOfMember(eventId, user.Id, &event); err != nil {
+		return err
+	}
+
+	var comment model.Comment
+	if err := s.findComment(commentId, eventId, &comment); err != nil {
+		return err
+	}
+	if comment.DeletedAt != nil {
+		return constants.ERR_COMMENT_NOT_FOUND.Err
+	}
+	if comment.AuthorId != user.Id && !event.Can(&user.Id, constants.EVENT_PERMISSION_ADMINISTER) {
+		return constants.ERR_COMMENT_ACCESS_DENIED.Err
+	}
+
+	if err := s.commentRepository.Delete(commentId, user.Id, time.Now().UTC()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_COMMENT_NOT_FOUND.Err
+		}
+		return err
+	}
+	if err := s.findComment(commentId, eventId, &comment); err != nil {
+		return err
+	}
+
+	s.sseService.Broadcast(eventId, constants.SSE_EVENT_COMMENT, CommentEventDto{Change: constants.COMMENT_CHANGE_DELETED, Comment: MapToCommentResponseDto(comment)})
+
+	return nil
+}
+
+// SetDigest subscribes the user to the daily email digest of the new comments of the event, or unsubscribes them
+func (s *CommentService) SetDigest(eventId uuid.UUID, data *CommentDigestDto, user *guard.Claims) error {
+	if err := s.accountEventRepository.SetCommentDigest(user.Id, eventId, *data.Enabled, time.Now().UTC()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	return nil
+}
+
+// notifyMentions sends the comment to the mentioned members on their SSE connection and by email
+func (s *CommentService) notifyMentions(event model.Event, comment model.Comment, mentioned []model.Account, response CommentResponseDto) {
+	authorName := ""
+	if comment.Author.UserName != nil {
+		authorName = *comment.Author.UserName
+	}
+
+	for _, account := range mentioned {
+		s.sseService.SendToUser(event.Id, account.Id, constants.SSE_EVENT_COMMENT_MENTION, response)
+		go s.mailService.SendCommentMentionEmail(account, event, authorName, comment.Body)
+	}
+}
+
+// mentionedMembers returns the members of the event other than the author mentioned in the body with @ followed by their username, case-insensitively
+func mentionedMembers(body string, event model.Event, authorId uuid.UUID) []model.Account {
+	body = strings.ToLower(body)
+
+	accounts := []model.Account{}
+	for _, accountEvent := range event.AccountEvents {
+		if accountEvent.AccountId == authorId || accountEvent.Account.UserName == nil {
+			continue
+		}
+		if isMentioned(body, strings.ToLower(*accountEvent.Account.UserName)) {
+			accounts = append(accounts, accountEvent.Account)
+		}
+	}
+
+	return accounts
+}
+
+// isMentioned tells whether the lowercase text contains @ followed by the lowercase username as a whole word
+func isMentioned(text string, userName string) bool {
+	if userName == "" {
+		return false
+	}
+
+	mention := "@" + userName
+	for offset := 0; ; {
+		index := strings.Index(text[offset:], mention)
+		if index < 0 {
+			return false
+		}
+		start := offset + index
+		end := start + len(mention)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+
+		offset = start + 1
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
@@ -0,0 +1,182 @@
+package comment
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	model "app/db/models"
+	"app/db/repository"
+	"app/pkg/mail"
+	"app/pkg/sse"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func member(userName string) model.AccountEvent {
+	id := uuid.New()
+	return model.AccountEvent{AccountId: id, Account: model.Account{Id: id, UserName: &userName}}
+}
+
+func TestMentionedMembers(t *testing.T) {
+	author := member("alice")
+	bob := member("Bob")
+	bobSmith := member("bob smith")
+	carol := member("carol")
+	event := model.Event{AccountEvents: []model.AccountEvent{author, bob, bobSmith, carol}}
+
+	mentioned := mentionedMembers("Thanks @BOB, and @alice for the room", event, author.AccountId)
+	assert.Len(t, mentioned, 1, "the author is not notified of their own mention")
+	assert.Equal(t, bob.AccountId, mentioned[0].Id)
+
+	mentioned = mentionedMembers("@bob smith can you bring the slides?", event, author.AccountId)
+	assert.Len(t, mentioned, 2)
+
+	assert.Empty(t, mentionedMembers("Write to carol@example.com or @bobby", event, author.AccountId))
+	assert.Empty(t, mentionedMembers("@carol_2 is not carol", event, author.AccountId))
+	assert.Len(t, mentionedMembers("Done (@carol).", event, author.AccountId), 1)
+}
+
+func TestMapToCommentResponseDto(t *testing.T) {
+	authorId := uuid.New()
+	deletedAt := time.Now()
+	comment := model.Comment{Id: uuid.New(), AuthorId: authorId, Body: "See you there"}
+
+	dto := MapToCommentResponseDto(comment)
+	assert.Equal(t, "See you there", *dto.Body)
+	assert.False(t, dto.RemovedByModerator)
+
+	comment.Body = ""
+	comment.DeletedAt = &deletedAt
+	comment.DeletedById = &authorId
+	dto = MapToCommentResponseDto(comment)
+	assert.Nil(t, dto.Body)
+	assert.False(t, dto.RemovedByModerator)
+
+	moderatorId := uuid.New()
+	comment.DeletedById = &moderatorId
+	assert.True(t, MapToCommentResponseDto(comment).RemovedByModerator)
+}
+
+// threadFixture is an event with an owner, an organiser and two participants, and a comment service on sqlite.
+// The accounts have no email so that the mention emails are skipped.
+type threadFixture struct {
+	service                             *CommentService
+	eventId                             uuid.UUID
+	owner, organiser, alice, bob, carol *guard.Claims
+}
+
+func newThreadFixture(t *testing.T) threadFixture {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, database.AutoMigrate(&model.Account{}, &model.Event{}, &model.AccountEvent{}, &model.Slot{}, &model.Availability{}, &model.Comment{}))
+
+	event := model.Event{Id: uuid.New(), Name: "Team meeting", OwnerId: uuid.New(), Status: constants.EVENT_STATUS_IN_DECISION}
+	createMember := func(userName string, role constants.EventRole) *guard.Claims {
+		id := uuid.New()
+		if role == constants.EVENT_ROLE_OWNER {
+			id = event.OwnerId
+		}
+		require.NoError(t, database.Create(&model.Account{Id: id, UserName: &userName}).Error)
+		require.NoError(t, database.Omit("Account", "Event").Create(&model.AccountEvent{AccountId: id, EventId: event.Id, Role: role}).Error)
+		return &guard.Claims{Id: id, Username: &userName}
+	}
+
+	fixture := threadFixture{eventId: event.Id}
+	fixture.owner = createMember("owner", constants.EVENT_ROLE_OWNER)
+	require.NoError(t, database.Omit("Owner").Create(&event).Error)
+	fixture.organiser = createMember("organiser", constants.EVENT_ROLE_ORGANISER)
+	fixture.alice = createMember("alice", constants.EVENT_ROLE_PARTICIPANT)
+	fixture.bob = createMember("bob", constants.EVENT_ROLE_PARTICIPANT)
+	fixture.carol = createMember("carol", constants.EVENT_ROLE_PARTICIPANT)
+
+	fixture.service = &CommentService{
+		commentRepository:      repository.NewCommentRepository(database),
+		eventRepository:        repository.NewEventRepository(database),
+		accountEventRepository: repository.NewAccountEventRepository(database),
+		sseService:             sse.NewSSEService(),
+		mailService:            &mail.MailService{},
+	}
+	return fixture
+}
+
+// mentionsReceived returns the number of mention frames sent to the client
+func mentionsReceived(client *sse.SSEClient) int {
+	count := 0
+	for {
+		select {
+		case message := <-client.Channel:
+			if message.Event == constants.SSE_EVENT_COMMENT_MENTION {
+				count++
+			}
+		default:
+			return count
+		}
+	}
+}
+
+func TestUpdateByAuthorOnly(t *testing.T) {
+	f := newThreadFixture(t)
+	comment, err := f.service.Create(f.eventId, &CommentCreateDto{Body: "Room 4 works for me"}, f.alice)
+	require.NoError(t, err)
+
+	_, err = f.service.Update(f.eventId, comment.Id, &CommentUpdateDto{Body: "Room 5 works for me"}, f.owner)
+	assert.ErrorIs(t, err, constants.ERR_COMMENT_ACCESS_DENIED.Err, "the owner moderates but does not edit")
+	_, err = f.service.Update(f.eventId, comment.Id, &CommentUpdateDto{Body: "Room 5 works for me"}, f.bob)
+	assert.ErrorIs(t, err, constants.ERR_COMMENT_ACCESS_DENIED.Err)
+
+	updated, err := f.service.Update(f.eventId, comment.Id, &CommentUpdateDto{Body: "Room 5 works for me"}, f.alice)
+	require.NoError(t, err)
+	assert.Equal(t, "Room 5 works for me", *updated.Body)
+}
+
+func TestUpdateRejectedOnDeletedComment(t *testing.T) {
+	f := newThreadFixture(t)
+	comment, err := f.service.Create(f.eventId, &CommentCreateDto{Body: "Room 4 works for me"}, f.alice)
+	require.NoError(t, err)
+	require.NoError(t, f.service.Delete(f.eventId, comment.Id, f.alice))
+
+	_, err = f.service.Update(f.eventId, comment.Id, &CommentUpdateDto{Body: "Room 5 works for me"}, f.alice)
+	assert.ErrorIs(t, err, constants.ERR_COMMENT_NOT_FOUND.Err)
+	assert.ErrorIs(t, f.service.Delete(f.eventId, comment.Id, f.alice), constants.ERR_COMMENT_NOT_FOUND.Err)
+}
+
+func TestDeleteModeratedByOwnerOnly(t *testing.T) {
+	f := newThreadFixture(t)
+	comment, err := f.service.Create(f.eventId, &CommentCreateDto{Body: "Room 4 works for me"}, f.alice)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, f.service.Delete(f.eventId, comment.Id, f.organiser), constants.ERR_COMMENT_ACCESS_DENIED.Err, "moderation needs the ADMINISTER permission")
+	assert.ErrorIs(t, f.service.Delete(f.eventId, comment.Id, f.bob), constants.ERR_COMMENT_ACCESS_DENIED.Err)
+
+	require.NoError(t, f.service.Delete(f.eventId, comment.Id, f.owner))
+	var deleted model.Comment
+	require.NoError(t, f.service.findComment(comment.Id, f.eventId, &deleted))
+	assert.True(t, MapToCommentResponseDto(deleted).RemovedByModerator)
+}
+
+func TestUpdateNotifiesNewMentionsOnly(t *testing.T) {
+	f := newThreadFixture(t)
+	bobClient := f.service.sseService.AddClient("bob", f.bob.Id, f.eventId, context.Background())
+	carolClient := f.service.sseService.AddClient("carol", f.carol.Id, f.eventId, context.Background())
+
+	comment, err := f.service.Create(f.eventId, &CommentCreateDto{Body: "@bob can you book a room?"}, f.alice)
+	require.NoError(t, err)
+	assert.Equal(t, 1, mentionsReceived(bobClient))
+	assert.Equal(t, 0, mentionsReceived(carolClient))
+
+	_, err = f.service.Update(f.eventId, comment.Id, &CommentUpdateDto{Body: "@bob can you book a room? @carol brings the slides"}, f.alice)
+	require.NoError(t, err)
+	assert.Equal(t, 0, mentionsReceived(bobClient), "bob was already mentioned")
+	assert.Equal(t, 1, mentionsReceived(carolClient))
+
+	_, err = f.service.Update(f.eventId, comment.Id, &CommentUpdateDto{Body: "@carol brings the slides"}, f.alice)
+	require.NoError(t, err)
+	assert.Equal(t, 0, mentionsReceived(bobClient))
+	assert.Equal(t, 0, mentionsReceived(carolClient))
+}
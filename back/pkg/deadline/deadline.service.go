@@ -0,0 +1,164 @@
+package deadline
+
+import (
+	"app/commons/constants"
+	"app/commons/lib"
+	model "app/db/models"
+	"app/db/repository"
+	"app/pkg/mail"
+	"app/pkg/slot"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+const (
+	checkInterval = time.Minute    // Interval between two checks of the deadlines
+	reminderLead  = 24 * time.Hour // Participants without availabilities are reminded this long before the deadline
+)
+
+// slotConfirmer confirms the best slot of an event, implemented by slot.SlotService
+type slotConfirmer interface {
+	ConfirmBestSlot(eventId uuid.UUID) (*slot.SlotResponseDto, error)
+}
+
+// DeadlineService handles the decision deadlines of the events in the background:
+// it reminds the participants who did not answer, then confirms the best slot once the deadline has passed.
+type DeadlineService struct {
+	eventRepository   *repository.EventRepository
+	accountRepository *repository.AccountRepository
+	slotService       slotConfirmer
+	mailService       *mail.MailService
+}
+
+func NewDeadlineService(service *DeadlineService) *DeadlineService {
+	if service != nil {
+		return service
+	}
+
+	return &DeadlineService{
+		eventRepository:   repository.NewEventRepository(nil),
+		accountRepository: repository.NewAccountRepository(nil),
+		slotService:       slot.NewSlotService(nil),
+		mailService:       mail.NewMailService(nil),
+	}
+}
+
+// Start checks the deadlines periodically until the context is done
+func (s *DeadlineService) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.Check(time.Now())
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Check sends the reminders of the deadlines that are close and handles the deadlines that have passed
+func (s *DeadlineService) Check(now time.Time) {
+	s.sendReminders(now)
+	s.handlePassedDeadlines(now)
+}
+
+func (s *DeadlineService) sendReminders(now time.Time) {
+	events, err := s.eventRepository.FindEventsToRemind(now, now.Add(reminderLead))
+	if err != nil {
+		return
+	}
+
+	for _, candidate := range events {
+		if err := s.eventRepository.MarkDeadlineReminded(candidate.Id, now); err != nil {
+			continue
+		}
+
+		var event model.Event
+		if err := s.eventRepository.FindOneById(candidate.Id, &event); err != nil {
+			continue
+		}
+
+		for _, participant := range nonResponders(event) {
+			go s.mailService.SendDeadlineReminderEmail(participant, event)
+		}
+	}
+}
+
+func (s *DeadlineService) handlePassedDeadlines(now time.Time) {
+	events, err := s.eventRepository.FindEventsPastDeadline(now)
+	if err != nil {
+		return
+	}
+
+	for _, candidate := range events {
+		if err := s.eventRepository.MarkDeadlineProcessed(candidate.Id, now); err != nil {
+			continue
+		}
+
+		confirmedSlot, err := s.slotService.ConfirmBestSlot(candidate.Id)
+		if err != nil && isTransient(err) {
+			log.Error().Err(err).Str("eventId", candidate.Id.String()).Msg("DEADLINE_SERVICE::HANDLE_PASSED_DEADLINES Failed to confirm the best slot, retrying on the next check")
+			_ = s.eventRepository.ClearDeadlineProcessed(candidate.Id)
+			continue
+		}
+		if confirmedSlot != nil {
+			continue
+		}
+
+		// No slot could be confirmed, e.g. none fits anymore or the event changed, the owner decides instead
+		s.notifyOwnerNoSlot(candidate.Id)
+	}
+}
+
+func (s *DeadlineService) notifyOwnerNoSlot(eventId uuid.UUID) {
+	var event model.Event
+	if err := s.eventRepository.FindOneById(eventId, &event); err != nil {
+		return
+	}
+
+	var owner model.Account
+	if err := s.accountRepository.FindOneById(event.OwnerId, &owner); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Str("eventId", event.Id.String()).Msg("DEADLINE_SERVICE::NOTIFY_OWNER_NO_SLOT Failed to get owner")
+		}
+		return
+	}
+
+	go s.mailService.SendDeadlineMissedEmail(owner, event)
+}
+
+// isTransient tells whether confirming the best slot may succeed on the next check: a concurrent change
+// of the event or a database error. The other errors of the app would fail the same way again.
+func isTransient(err error) bool {
+	return errors.Is(err, constants.ERR_EVENT_VERSION_CONFLICT.Err) || !lib.IsCustomError(err)
+}
+
+// nonResponders returns the members who can submit availabilities but have not submitted any, except the owner who set the deadline
+func nonResponders(event model.Event) []model.Account {
+	answered := make(map[uuid.UUID]bool, len(event.Availabilities))
+	for _, availability := range event.Availabilities {
+		answered[availability.AccountId] = true
+	}
+
+	accounts := []model.Account{}
+	for _, accountEvent := range event.AccountEvents {
+		if accountEvent.AccountId == event.OwnerId || answered[accountEvent.AccountId] {
+			continue
+		}
+		if !event.Can(&accountEvent.AccountId, constants.EVENT_PERMISSION_CONTRIBUTE) {
+			continue
+		}
+		accounts = append(accounts, accountEvent.Account)
+	}
+
+	return accounts
+}
@@ -0,0 +1,92 @@
+package deadline
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
+	"app/pkg/slot"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestNonResponders(t *testing.T) {
+	ownerId := uuid.New()
+	answeredId := uuid.New()
+	silentId := uuid.New()
+	viewerId := uuid.New()
+	startsAt := time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC)
+
+	event := model.Event{
+		OwnerId: ownerId,
+		AccountEvents: []model.AccountEvent{
+			{AccountId: ownerId, Role: constants.EVENT_ROLE_OWNER, Account: model.Account{Id: ownerId}},
+			{AccountId: answeredId, Account: model.Account{Id: answeredId}},
+			{AccountId: silentId, Account: model.Account{Id: silentId}},
+			{AccountId: viewerId, Role: constants.EVENT_ROLE_VIEWER, Account: model.Account{Id: viewerId}},
+		},
+		Availabilities: []model.Availability{
+			{AccountId: answeredId, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)},
+		},
+	}
+
+	result := nonResponders(event)
+
+	assert.Len(t, result, 1)
+	assert.Equal(t, silentId, result[0].Id)
+}
+
+type failingSlotConfirmer struct {
+	err error
+}
+
+func (c failingSlotConfirmer) ConfirmBestSlot(uuid.UUID) (*slot.SlotResponseDto, error) {
+	return nil, c.err
+}
+
+func TestHandlePassedDeadlinesWhenConfirmationFails(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		shouldRetry bool
+	}{
+		{"version conflict", constants.ERR_EVENT_VERSION_CONFLICT.Err, true},
+		{"database error", errors.New("connection reset"), true},
+		{"event cancelled", constants.ERR_EVENT_CANCELLED.Err, false},
+		{"slot no longer fits", constants.ERR_SLOT_INVALID_ENDS_AT.Err, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+			require.NoError(t, err)
+			require.NoError(t, database.AutoMigrate(&model.Event{}, &model.Account{}))
+
+			now := time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC)
+			deadline := now.Add(-time.Hour)
+			event := model.Event{Id: uuid.New(), Name: "Team meeting", OwnerId: uuid.New(), Status: constants.EVENT_STATUS_IN_DECISION, DecisionDeadline: &deadline}
+			require.NoError(t, database.Omit("Owner").Create(&event).Error)
+
+			service := &DeadlineService{
+				eventRepository:   repository.NewEventRepository(database),
+				accountRepository: repository.NewAccountRepository(database),
+				slotService:       failingSlotConfirmer{err: tt.err},
+			}
+
+			service.handlePassedDeadlines(now)
+
+			events, err := service.eventRepository.FindEventsPastDeadline(now)
+			require.NoError(t, err)
+			if tt.shouldRetry {
+				assert.Len(t, events, 1, "the deadline is handled again on the next check")
+			} else {
+				assert.Empty(t, events, "the deadline stays handled and the owner is told instead")
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package digest
+
+import (
+	"app/db/repository"
+	"app/pkg/mail"
+	"context"
+	"time"
+)
+
+const (
+	checkInterval  = 10 * time.Minute // Interval between two checks of the digests
+	digestInterval = 24 * time.Hour   // Subscribed members receive at most one digest per event in this period
+)
+
+// DigestService sends in the background the digests of the new comments to the members who subscribed to them
+type DigestService struct {
+	accountEventRepository *repository.AccountEventRepository
+	commentRepository      *repository.CommentRepository
+	mailService            *mail.MailService
+}
+
+func NewDigestService(service *DigestService) *DigestService {
+	if service != nil {
+		return service
+	}
+
+	return &DigestService{
+		accountEventRepository: repository.NewAccountEventRepository(nil),
+		commentRepository:      repository.NewCommentRepository(nil),
+		mailService:            mail.NewMailService(nil),
+	}
+}
+
+// Start checks the digests periodically until the context is done
+func (s *DigestService) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.Check(time.Now())
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Check sends the digests whose period has passed. The period starts again even when there was no new comment to send.
+// A digest is marked as sent before the email goes out: the mark is what lets a single check send it, so a digest is sent
+// at most once and a failed email is not sent again, its comments are not repeated in the next digest either.
+func (s *DigestService) Check(now time.Time) {
+	now = now.UTC()
+
+	accountEvents, err := s.accountEventRepository.FindDueCommentDigests(now.Add(-digestInterval))
+	if err != nil {
+		return
+	}
+
+	for _, accountEvent := range accountEvents {
+		if accountEvent.CommentDigestSentAt == nil {
+			continue
+		}
+		since := *accountEvent.CommentDigestSentAt
+
+		comments, err := s.commentRepository.FindForDigest(accountEvent.EventId, accountEvent.AccountId, since, now)
+		if err != nil {
+			continue
+		}
+
+		// Claims the digest, another check that marked it first sends it instead
+		if err := s.accountEventRepository.MarkCommentDigestSent(accountEvent.AccountId, accountEvent.EventId, since, now); err != nil {
+			continue
+		}
+
+		if len(comments) > 0 {
+			go s.mailService.SendCommentDigestEmail(accountEvent.Account, accountEvent.Event, comments)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package domainevent
+
+import (
+	"app/commons/constants"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Handler reacts to a published domain event. Handlers run synchronously in the publishing goroutine,
+// so slow work such as sending emails must be started in its own goroutine.
+type Handler func(event DomainEvent)
+
+// Bus dispatches the domain events to the handlers subscribed to their type
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[constants.DomainEventType][]Handler
+}
+
+var busInstance *Bus
+var busOnce sync.Once
+
+// GetBus returns the singleton bus shared by the services
+func GetBus() *Bus {
+	busOnce.Do(func() {
+		busInstance = NewBus()
+	})
+	return busInstance
+}
+
+// NewBus creates a bus without any handler
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[constants.DomainEventType][]Handler),
+	}
+}
+
+// Subscribe registers the handler for every domain event of the given type
+func (b *Bus) Subscribe(eventType constants.DomainEventType, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Publish calls the handlers subscribed to the type of the domain event.
+// A failing handler is logged and does not prevent the next ones from running.
+func (b *Bus) Publish(event DomainEvent) {
+	b.mu.RLock()
+	handlers := b.handlers[event.Type]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.call(handler, event)
+	}
+}
+
+func (b *Bus) call(handler Handler, event DomainEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error().Interface("panic", r).Str("type", string(event.Type)).Str("eventId", event.EventId.String()).Msg("DOMAIN_EVENT_BUS::PUBLISH Handler failed")
+		}
+	}()
+
+	handler(event)
+}
@@ -0,0 +1,147 @@
+package domainevent
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"maps"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DomainEvent is something that happened to an event, published so that other features can react to it
+type DomainEvent struct {
+	Type       constants.DomainEventType
+	EventId    uuid.UUID
+	ActorId    *uuid.UUID // Nil when the change was made by the app itself, e.g. by the lifecycle scheduler
+	AccountId  *uuid.UUID // Member concerned by the change, nil when the change is about the event itself
+	OccurredAt time.Time
+	Payload    any
+}
+
+// StatusChanged is the payload of DOMAIN_EVENT_STATUS_CHANGED
+type StatusChanged struct {
+	From constants.EventStatus
+	To   constants.EventStatus
+}
+
+// NewStatusChanged returns the domain event of an event moving from one status to another
+func NewStatusChanged(eventId uuid.UUID, from, to constants.EventStatus, actorId *uuid.UUID) DomainEvent {
+	return DomainEvent{
+		Type:       constants.DOMAIN_EVENT_STATUS_CHANGED,
+		EventId:    eventId,
+		ActorId:    actorId,
+		OccurredAt: time.Now(),
+		Payload:    StatusChanged{From: from, To: to},
+	}
+}
+
+// Change is the payload of the domain events describing a change, with the values of the changed fields
+// before and after it. Before is nil for a creation and After is nil for a deletion.
+type Change struct {
+	Before map[string]any
+	After  map[string]any
+}
+
+// NewChange returns the domain event of a change made to an event, the fields having the same value
+// before and after the change are left out
+func NewChange(eventType constants.DomainEventType, eventId uuid.UUID, actorId *uuid.UUID, before, after map[string]any) DomainEvent {
+	if before != nil && after != nil {
+		before, after = maps.Clone(before), maps.Clone(after)
+		for field, value := range before {
+			if otherValue, found := after[field]; found && reflect.DeepEqual(value, otherValue) {
+				delete(before, field)
+				delete(after, field)
+			}
+		}
+	}
+
+	return DomainEvent{
+		Type:       eventType,
+		EventId:    eventId,
+		ActorId:    actorId,
+		OccurredAt: time.Now(),
+		Payload:    Change{Before: before, After: after},
+	}
+}
+
+// Changeable lists the records whose changes are published with NewChange
+type Changeable interface {
+	model.Event | model.Slot | model.Availability
+}
+
+// FieldsOf returns the fields of the record kept in the activity log, to pass as before or after to NewChange.
+// The values are copied, so that they stay as they are when the record is changed afterwards.
+func FieldsOf[T Changeable](record T) map[string]any {
+	switch record := any(record).(type) {
+	case model.Event:
+		return map[string]any{
+			"name":             record.Name,
+			"description":      valueOf(record.Description),
+			"duration":         record.Duration,
+			"startsAt":         formatTime(&record.StartsAt),
+			"endsAt":           formatTime(&record.EndsAt),
+			"inviteOnly":       record.InviteOnly,
+			"requiresApproval": record.RequiresApproval,
+			"address":          valueOf(record.Address),
+			"meetingUrl":       valueOf(record.MeetingUrl),
+			"autoMeetingLink":  record.AutoMeetingLink,
+			"autoConfirm":      record.AutoConfirm,
+			"decisionDeadline": formatTime(record.DecisionDeadline),
+			"maxParticipants":  valueOf(record.MaxParticipants),
+		}
+	case model.Slot:
+		return map[string]any{
+			"id":       record.Id,
+			"startsAt": formatTime(&record.StartsAt),
+			"endsAt":   formatTime(&record.EndsAt),
+		}
+	case model.Availability:
+		return map[string]any{
+			"id":       record.Id,
+			"startsAt": formatTime(&record.StartsAt),
+			"endsAt":   formatTime(&record.EndsAt),
+			"note":     valueOf(record.Note),
+		}
+	}
+
+	return nil
+}
+
+// valueOf returns the pointed value, nil when the pointer is nil
+func valueOf[T any](value *T) any {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+// formatTime returns the time in UTC as RFC 3339, nil when the pointer is nil
+func formatTime(value *time.Time) any {
+	if value == nil {
+		return nil
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
+// IsEmpty tells whether the change has no field left, e.g. when an update kept every value
+func (c Change) IsEmpty() bool {
+	return len(c.Before) == 0 && len(c.After) == 0
+}
+
+// WithAccount returns the domain event concerning the given member
+func (e DomainEvent) WithAccount(accountId uuid.UUID) DomainEvent {
+	e.AccountId = &accountId
+	return e
+}
+
+// NewMemberJoined returns the domain event of an account becoming a member of the event with the given role
+func NewMemberJoined(eventId uuid.UUID, accountId uuid.UUID, role constants.EventRole, actorId *uuid.UUID) DomainEvent {
+	return NewChange(constants.DOMAIN_EVENT_MEMBER_JOINED, eventId, actorId, nil, map[string]any{"role": role}).WithAccount(accountId)
+}
+
+// NewMemberLeft returns the domain event of a member leaving the event, or being removed by the actor
+func NewMemberLeft(eventId uuid.UUID, accountId uuid.UUID, role constants.EventRole, actorId *uuid.UUID) DomainEvent {
+	return NewChange(constants.DOMAIN_EVENT_MEMBER_LEFT, eventId, actorId, map[string]any{"role": role}, nil).WithAccount(accountId)
+}
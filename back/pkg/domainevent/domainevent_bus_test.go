@@ -0,0 +1,33 @@
+package domainevent
+
+import (
+	"app/commons/constants"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublish_CallsSubscribedHandlers(t *testing.T) {
+	bus := NewBus()
+	eventId := uuid.New()
+
+	var received []DomainEvent
+	bus.Subscribe(constants.DOMAIN_EVENT_STATUS_CHANGED, func(event DomainEvent) {
+		panic("a failing handler")
+	})
+	bus.Subscribe(constants.DOMAIN_EVENT_STATUS_CHANGED, func(event DomainEvent) {
+		received = append(received, event)
+	})
+	bus.Subscribe("other", func(event DomainEvent) {
+		t.Error("handlers of other types are not called")
+	})
+
+	bus.Publish(NewStatusChanged(eventId, constants.EVENT_STATUS_UPCOMING, constants.EVENT_STATUS_FINISHED, nil))
+
+	if assert.Len(t, received, 1, "the failing handler does not stop the next ones") {
+		assert.Equal(t, eventId, received[0].EventId)
+		assert.Nil(t, received[0].ActorId)
+		assert.Equal(t, StatusChanged{From: constants.EVENT_STATUS_UPCOMING, To: constants.EVENT_STATUS_FINISHED}, received[0].Payload)
+	}
+}
@@ -0,0 +1,46 @@
+package domainevent
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChange_LeavesOutUnchangedFields(t *testing.T) {
+	before := map[string]any{"name": "Team meeting", "duration": 60}
+	after := map[string]any{"name": "Team lunch", "duration": 60}
+
+	change := NewChange(constants.DOMAIN_EVENT_EVENT_UPDATED, uuid.New(), nil, before, after).Payload.(Change)
+
+	assert.Equal(t, map[string]any{"name": "Team meeting"}, change.Before)
+	assert.Equal(t, map[string]any{"name": "Team lunch"}, change.After)
+	assert.Len(t, before, 2, "the given fields are not modified")
+
+	unchanged := NewChange(constants.DOMAIN_EVENT_EVENT_UPDATED, uuid.New(), nil, after, after).Payload.(Change)
+	assert.True(t, unchanged.IsEmpty())
+
+	created := NewChange(constants.DOMAIN_EVENT_MEMBER_JOINED, uuid.New(), nil, nil, after).Payload.(Change)
+	assert.Nil(t, created.Before)
+	assert.Equal(t, after, created.After)
+}
+
+func TestFieldsOf_CopiesTheValues(t *testing.T) {
+	description := "Weekly sync"
+	startsAt := time.Date(2025, 3, 10, 10, 0, 0, 0, time.FixedZone("CET", 3600))
+	event := model.Event{Name: "Team meeting", Description: &description, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}
+
+	fields := FieldsOf(event)
+	description = "Monthly sync"
+
+	assert.Equal(t, "Weekly sync", fields["description"], "a later change of the event does not alter the fields")
+	assert.Equal(t, "2025-03-10T09:00:00Z", fields["startsAt"])
+	assert.Nil(t, fields["address"])
+	assert.Nil(t, fields["decisionDeadline"])
+
+	slot := model.Slot{Id: uuid.New(), StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}
+	assert.Equal(t, map[string]any{"id": slot.Id, "startsAt": "2025-03-10T09:00:00Z", "endsAt": "2025-03-10T10:00:00Z"}, FieldsOf(slot))
+}
@@ -5,6 +5,11 @@ import (
 	"app/commons/guard"
 	"app/commons/helpers"
 	"app/commons/lib"
+	"app/pkg/joinrequest"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -24,6 +29,26 @@ func NewEventController(ctl *EventController) *EventController {
 	}
 }
 
+// formatETag returns the ETag header value of an event version
+func formatETag(version int) string {
+	return fmt.Sprintf("%q", strconv.Itoa(version))
+}
+
+// parseIfMatch returns the event version expected by the If-Match header, nil when any version is accepted
+func parseIfMatch(header string) (*int, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(header, "W/"), `"`))
+	if err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
 // @Summary Create an event
 // @Tags Event
 // @Accept json
@@ -31,7 +56,7 @@ func NewEventController(ctl *EventController) *EventController {
 // @Param data body EventCreateDto true "Event parameters"
 // @Security BearerAuth
 // @Success 200 {object} EventCreateResponseDto
-// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_START_AFTER_END, ERR_EVENT_START_BEFORE_TODAY, or ERR_EVENT_DURATION_TOO_SHORT"
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_START_AFTER_END, ERR_EVENT_START_BEFORE_TODAY, ERR_EVENT_DURATION_TOO_SHORT, ERR_EVENT_INVALID_MEETING_URL, or ERR_EVENT_INVALID_DECISION_DEADLINE"
 // @Router /api/v1/events [post]
 func (ctl *EventController) Create(c *gin.Context) {
 	var data EventCreateDto
@@ -55,10 +80,13 @@ func (ctl *EventController) Create(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param eventId path string true "Event Id"
+// @Description Send the version of the event in If-Match to only update it when nobody changed it in the meantime.
 // @Param data body EventUpdateDto true "Event parameters"
+// @Param If-Match header string false "Version of the event, as returned in the ETag header"
 // @Security BearerAuth
 // @Success 200
-// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_START_AFTER_END, ERR_EVENT_DURATION_TOO_SHORT, ERR_EVENT_START_BEFORE_TODAY, or ERR_VALIDATED_SLOT_CANNOT_BE_MODIFIED"
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_CANCELLED, ERR_EVENT_START_AFTER_END, ERR_EVENT_DURATION_TOO_SHORT, ERR_EVENT_START_BEFORE_TODAY, ERR_EVENT_INVALID_MEETING_URL, ERR_EVENT_INVALID_DECISION_DEADLINE, or ERR_VALIDATED_SLOT_CANNOT_BE_MODIFIED"
+// @Failure 412 {object} helpers.ApiError "Precondition Failed - Code can be: ERR_EVENT_VERSION_MISMATCH"
 // @Router /api/v1/events/{eventId} [patch]
 func (ctl *EventController) Update(c *gin.Context) {
 	var data EventUpdateDto
@@ -78,20 +106,102 @@ func (ctl *EventController) Update(c *gin.Context) {
 		return
 	}
 
-	err = ctl.eventService.Update(idUuid, &data, user)
+	expectedVersion, err := parseIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_VERSION_MISMATCH.Err)
+		return
+	}
+
+	err = ctl.eventService.Update(idUuid, &data, expectedVersion, user)
+
+	helpers.HandleJSONResponse(c, nil, err)
+}
+
+// @Summary Cancel an event
+// @Description Owner only. Every other member receives a cancellation email, and the event becomes read-only.
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param data body EventCancelDto true "Cancellation parameters"
+// @Security BearerAuth
+// @Success 200
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, or ERR_EVENT_CANCELLED"
+// @Router /api/v1/events/{eventId}/cancel [post]
+func (ctl *EventController) Cancel(c *gin.Context) {
+	var data EventCancelDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
 
+	idUuid, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	err = ctl.eventService.Cancel(idUuid, &data, user)
 	helpers.HandleJSONResponse(c, nil, err)
 }
 
+// @Summary Clone an event
+// @Description Creates a new event with the description, duration and settings of the source event, shifted to the given start date. Copied participants receive an invitation email.
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Source event Id"
+// @Param data body EventCloneDto true "Clone parameters"
+// @Security BearerAuth
+// @Success 200 {object} EventCreateResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_START_BEFORE_TODAY, ERR_EVENT_START_AFTER_END, or ERR_EVENT_DURATION_TOO_SHORT"
+// @Router /api/v1/events/{eventId}/clone [post]
+func (ctl *EventController) Clone(c *gin.Context) {
+	var data EventCloneDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	idUuid, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	result, err := ctl.eventService.Clone(idUuid, &data, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
 // @Summary Get user events
+// @Description Lists the events the user is a member of. The status counts ignore the status filter so that they can be shown next to it.
+// @Description In cursor mode the total is not returned, and the next page is requested with the nextCursor of the response until it is missing.
 // @Tags Event
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param cursor query string false "Cursor mode: empty for the first page, then the nextCursor of the previous page"
+// @Param status query []string false "Statuses to keep" collectionFormat(multi) Enums(IN_DECISION, UPCOMING, FINISHED, CANCELLED)
+// @Param ownership query string false "Owned or joined events" Enums(owned, joined)
+// @Param from query string false "Keeps the events ending after this date (RFC 3339)"
+// @Param to query string false "Keeps the events starting before this date (RFC 3339)"
+// @Param search query string false "Full-text search on the name and the description"
+// @Param sort query string false "Sort option" Enums(status, next_slot, created_at, name) default(status)
+// @Param order query string false "Sort direction" Enums(asc, desc) default(asc)
 // @Security BearerAuth
-// @Success 200 {object} lib.Pagination[EventListItemDto]
-// @Failure 400 {object} helpers.ApiError "Bad Request"
+// @Success 200 {object} EventListResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_INVALID_PAGINATION_PARAMS, ERR_INVALID_PAGINATION_CURSOR, or ERR_EVENT_INVALID_LIST_FILTER"
 // @Router /api/v1/events [get]
 func (ctl *EventController) GetUserEvents(c *gin.Context) {
 	var user *guard.Claims
@@ -100,19 +210,25 @@ func (ctl *EventController) GetUserEvents(c *gin.Context) {
 		return
 	}
 
-	var pagination lib.Pagination[EventListItemDto]
-	if err := pagination.ParseQuery(c); err != nil {
+	var result EventListResponseDto
+	if err := result.ParseCursorQuery(c); err != nil {
 		helpers.HandleJSONResponse(c, nil, err)
 		return
 	}
 
-	err := ctl.eventService.GetUserEvents(user, &pagination)
+	var query EventListQueryDto
+	if err := c.ShouldBindQuery(&query); err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_INVALID_LIST_FILTER.Err)
+		return
+	}
+
+	err := ctl.eventService.GetUserEvents(user, &query, &result)
 	if err != nil {
 		helpers.HandleJSONResponse(c, nil, err)
 		return
 	}
 
-	c.JSON(200, pagination)
+	c.JSON(200, result)
 }
 
 // @Summary Get event summary
@@ -141,7 +257,8 @@ func (ctl *EventController) GetEventSummary(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Success 200 {object} EventFullResponseDto
-// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND"
+// @Header 200 {string} ETag "Version of the event, to send in If-Match when updating it"
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_JOIN_REQUEST_PENDING"
 // @Router /api/v1/events/{eventId} [get]
 func (ctl *EventController) GetEvent(c *gin.Context) {
 	var user *guard.Claims
@@ -157,17 +274,23 @@ func (ctl *EventController) GetEvent(c *gin.Context) {
 	}
 
 	result, err := ctl.eventService.GetEvent(idUuid, user)
+	if err == nil {
+		c.Header("ETag", formatETag(result.Version))
+	}
 	helpers.HandleJSONResponse(c, result, err)
 }
 
 // @Summary Join event
+// @Description Invite-only events require the token of a valid invitation link. Events requiring approval answer 202 with the join request sent to the owner, full events answer 202 with a WAITLISTED request.
 // @Tags Event
 // @Param eventId path string true "Event Id"
+// @Param invitation query string false "Invitation token"
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Success 200 {object} EventFullResponseDto
-// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ALREADY_JOINED, or ERR_EVENT_ENDED"
+// @Success 202 {object} joinrequest.JoinRequestResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ALREADY_JOINED, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_INVITATION_REQUIRED, ERR_INVITATION_NOT_FOUND, ERR_INVITATION_EXPIRED, ERR_INVITATION_REVOKED, ERR_INVITATION_MAX_USES_REACHED, ERR_JOIN_REQUEST_PENDING, ERR_JOIN_REQUEST_REJECTED, or ERR_JOIN_REQUEST_WAITLISTED"
 // @Router /api/v1/events/{eventId}/join [post]
 func (ctl *EventController) JoinEvent(c *gin.Context) {
 	var user *guard.Claims
@@ -182,8 +305,68 @@ func (ctl *EventController) JoinEvent(c *gin.Context) {
 		return
 	}
 
-	result, err := ctl.eventService.JoinEvent(idUuid, user)
-	helpers.HandleJSONResponse(c, result, err)
+	var invitationToken *string
+	if token := c.Query("invitation"); token != "" {
+		invitationToken = &token
+	}
+
+	result, joinRequest, err := ctl.eventService.JoinEvent(idUuid, invitationToken, user)
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	ctl.handleJoinResponse(c, result, joinRequest)
+}
+
+// handleJoinResponse answers 202 with the join request when the owner has to approve the user
+func (ctl *EventController) handleJoinResponse(c *gin.Context, result EventFullResponseDto, joinRequest *joinrequest.JoinRequestResponseDto) {
+	if joinRequest != nil {
+		c.AbortWithStatusJSON(http.StatusAccepted, joinRequest)
+		return
+	}
+
+	helpers.HandleJSONResponse(c, result, nil)
+}
+
+// @Summary Join event as guest
+// @Description Join an event without an account, using only a display name. The guest is identified by the guest_token cookie.
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param data body EventGuestJoinDto true "Guest parameters"
+// @Success 200 {object} EventFullResponseDto
+// @Success 202 {object} joinrequest.JoinRequestResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ALREADY_JOINED, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_ALREADY_AUTHENTICATED, ERR_INVITATION_REQUIRED, ERR_INVITATION_NOT_FOUND, ERR_INVITATION_EXPIRED, ERR_INVITATION_REVOKED, ERR_INVITATION_MAX_USES_REACHED, ERR_JOIN_REQUEST_PENDING, ERR_JOIN_REQUEST_REJECTED, or ERR_JOIN_REQUEST_WAITLISTED"
+// @Router /api/v1/events/{eventId}/guest [post]
+func (ctl *EventController) JoinAsGuest(c *gin.Context) {
+	var data EventGuestJoinDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	idUuid, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	result, joinRequest, guestToken, err := ctl.eventService.JoinAsGuest(idUuid, &data, user)
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	lib.SetGuestTokenCookie(c, guestToken, 0)
+
+	ctl.handleJoinResponse(c, result, joinRequest)
 }
 
 // @Summary Update event profile
@@ -218,3 +401,102 @@ func (ctl *EventController) UpdateProfile(c *gin.Context) {
 
 	helpers.HandleJSONResponse(c, nil, err)
 }
+
+// @Summary Update participant role
+// @Description Owner only. Organisers can edit the event and confirm slots, viewers can follow the event without submitting availabilities.
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param participantId path string true "Participant account Id"
+// @Param data body EventRoleUpdateDto true "Role parameters"
+// @Security BearerAuth
+// @Success 200 {object} EventParticipantDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, or ERR_EVENT_OWNER_ROLE_LOCKED"
+// @Router /api/v1/events/{eventId}/participants/{participantId}/role [patch]
+func (ctl *EventController) UpdateParticipantRole(c *gin.Context) {
+	var data EventRoleUpdateDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	idUuid, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	participantId, err := uuid.Parse(c.Param("participantId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_PARTICIPANT_NOT_FOUND.Err)
+		return
+	}
+
+	result, err := ctl.eventService.UpdateParticipantRole(idUuid, participantId, &data, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary Leave an event
+// @Description Removes the current user and their availabilities from the event, the first waitlisted user takes the seat. Waitlisted users leave the waitlist. The owner has to transfer the ownership before leaving.
+// @Tags Event
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Security BearerAuth
+// @Success 200
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_EVENT_OWNER_CANNOT_LEAVE"
+// @Router /api/v1/events/{eventId}/leave [post]
+func (ctl *EventController) Leave(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	idUuid, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	err = ctl.eventService.Leave(idUuid, user)
+	helpers.HandleJSONResponse(c, nil, err)
+}
+
+// @Summary Remove a participant
+// @Description Owner only. Removes the participant and their availabilities from the event.
+// @Tags Event
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param participantId path string true "Participant account Id"
+// @Security BearerAuth
+// @Success 200
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, or ERR_EVENT_OWNER_CANNOT_LEAVE"
+// @Router /api/v1/events/{eventId}/participants/{participantId} [delete]
+func (ctl *EventController) RemoveParticipant(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	idUuid, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return
+	}
+
+	participantId, err := uuid.Parse(c.Param("participantId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_PARTICIPANT_NOT_FOUND.Err)
+		return
+	}
+
+	err = ctl.eventService.RemoveParticipant(idUuid, participantId, user)
+	helpers.HandleJSONResponse(c, nil, err)
+}
@@ -1,30 +1,100 @@
 package event
 
-import "time"
+import (
+	"app/commons/constants"
+	"time"
+)
 
 // EventCreateDto - POST /events
 type EventCreateDto struct {
-	Name        string    `json:"name" binding:"required,min=5,max=100"`
-	Description *string   `json:"description" binding:"omitempty,max=500"`
-	Days        int       `json:"days" binding:"min=0"`
-	Hours       int       `json:"hours" binding:"min=0,max=23"`
-	Minutes     int       `json:"minutes" binding:"min=0,max=59"`
-	StartsAt    time.Time `json:"startsAt" binding:"required"`
-	EndsAt      time.Time `json:"endsAt" binding:"required"`
+	Name             string     `json:"name" binding:"required,min=5,max=100"`
+	Description      *string    `json:"description" binding:"omitempty,max=500"`
+	Days             int        `json:"days" binding:"min=0"`
+	Hours            int        `json:"hours" binding:"min=0,max=23"`
+	Minutes          int        `json:"minutes" binding:"min=0,max=59"`
+	StartsAt         time.Time  `json:"startsAt" binding:"required"`
+	EndsAt           time.Time  `json:"endsAt" binding:"required"`
+	InviteOnly       *bool      `json:"inviteOnly"` // Defaults to true, the event can then only be joined with an invitation link
+	RequiresApproval bool       `json:"requiresApproval"`
+	Address          *string    `json:"address" binding:"omitempty,max=255"`
+	MeetingUrl       *string    `json:"meetingUrl" binding:"omitempty,max=500"`
+	AutoMeetingLink  bool       `json:"autoMeetingLink"`
+	AutoConfirm      bool       `json:"autoConfirm"`
+	DecisionDeadline *time.Time `json:"decisionDeadline"`
+	// Members including the owner, the next ones are waitlisted
+	MaxParticipants *int `json:"maxParticipants" binding:"omitempty,min=2"`
 }
 
 // EventUpdateDto - PATCH /events/:id
 type EventUpdateDto struct {
-	Name        *string    `json:"name" binding:"omitempty,min=5,max=100"`
-	Description *string    `json:"description" binding:"omitempty,max=500"`
-	Days        *int       `json:"days" binding:"omitempty,min=0"`
-	Hours       *int       `json:"hours" binding:"omitempty,min=0,max=23"`
-	Minutes     *int       `json:"minutes" binding:"omitempty,min=0,max=59"`
-	StartsAt    *time.Time `json:"startsAt"`
-	EndsAt      *time.Time `json:"endsAt"`
+	Name             *string    `json:"name" binding:"omitempty,min=5,max=100"`
+	Description      *string    `json:"description" binding:"omitempty,max=500"`
+	Days             *int       `json:"days" binding:"omitempty,min=0"`
+	Hours            *int       `json:"hours" binding:"omitempty,min=0,max=23"`
+	Minutes          *int       `json:"minutes" binding:"omitempty,min=0,max=59"`
+	StartsAt         *time.Time `json:"startsAt"`
+	EndsAt           *time.Time `json:"endsAt"`
+	InviteOnly       *bool      `json:"inviteOnly"`
+	RequiresApproval *bool      `json:"requiresApproval"`
+	// An empty string removes the address or the meeting link
+	Address    *string `json:"address" binding:"omitempty,max=255"`
+	MeetingUrl *string `json:"meetingUrl" binding:"omitempty,max=500"`
+	// Generate a meeting link when a slot is confirmed and the event has none
+	AutoMeetingLink *bool `json:"autoMeetingLink"`
+	// Confirm the slot once every member answered and a single slot remains
+	AutoConfirm *bool `json:"autoConfirm"`
+	// Setting a new deadline schedules a new reminder, removing it stops the automatic confirmation
+	DecisionDeadline       *time.Time `json:"decisionDeadline"`
+	RemoveDecisionDeadline bool       `json:"removeDecisionDeadline"`
+	// Lowering the capacity keeps the current members, raising or removing it lets waitlisted users in
+	MaxParticipants       *int `json:"maxParticipants" binding:"omitempty,min=2"`
+	RemoveMaxParticipants bool `json:"removeMaxParticipants"`
+}
+
+// EventListQueryDto - GET /events query parameters, next to the pagination ones
+type EventListQueryDto struct {
+	// Repeat the parameter to keep several statuses
+	Status []constants.EventStatus `form:"status" binding:"omitempty,dive,oneof=IN_DECISION UPCOMING FINISHED CANCELLED"`
+	// "owned" for the events of the user, "joined" for the events of other owners
+	Ownership string `form:"ownership" binding:"omitempty,oneof=owned joined"`
+	// Keeps the events whose period overlaps the range, RFC 3339 dates
+	From *time.Time `form:"from"`
+	To   *time.Time `form:"to"`
+	// Full-text search on the name and the description
+	Search string `form:"search" binding:"max=100"`
+	Sort   string `form:"sort" binding:"omitempty,oneof=status next_slot created_at name"`
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc"`
 }
 
 // EventProfileDto - PATCH /events/:id/profile
 type EventProfileDto struct {
 	Color string `json:"color"`
 }
+
+// EventRoleUpdateDto - PATCH /events/:id/participants/:participantId/role
+type EventRoleUpdateDto struct {
+	Role constants.EventRole `json:"role" binding:"required,oneof=ORGANISER PARTICIPANT VIEWER"`
+}
+
+// EventCancelDto - POST /events/:id/cancel
+type EventCancelDto struct {
+	Reason *string `json:"reason" binding:"omitempty,max=500"`
+}
+
+// EventCloneDto - POST /events/:id/clone
+type EventCloneDto struct {
+	// The end date and the decision deadline are shifted by the same amount
+	StartsAt time.Time `json:"startsAt" binding:"required"`
+	// Defaults to the name of the source event
+	Name             *string `json:"name" binding:"omitempty,min=5,max=100"`
+	WithParticipants bool    `json:"withParticipants"`
+}
+
+// EventGuestJoinDto - POST /events/:id/guest
+type EventGuestJoinDto struct {
+	DisplayName string                    `json:"displayName" binding:"required,min=3,max=30"`
+	Language    constants.AccountLanguage `json:"language" binding:"omitempty,oneof=en fr"`
+	TimeZone    string                    `json:"timeZone"`
+	// Required when the event is invite-only
+	InvitationToken *string `json:"invitationToken"`
+}
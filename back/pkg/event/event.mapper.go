@@ -2,10 +2,11 @@ package event
 
 import (
 	model "app/db/models"
+	"app/pkg/slot"
 )
 
-// durationToFields converts total minutes to days, hours, minutes
-func durationToFields(duration int) EventDurationFields {
+// DurationToFields converts total minutes to days, hours, minutes
+func DurationToFields(duration int) EventDurationFields {
 	days := duration / (24 * 60)
 	remaining := duration % (24 * 60)
 	hours := remaining / 60
@@ -13,6 +14,16 @@ func durationToFields(duration int) EventDurationFields {
 	return EventDurationFields{Days: days, Hours: hours, Minutes: minutes}
 }
 
+// mapToPreviousSlotDto maps the slot dropped by the last reschedule of the event, nil when it was never rescheduled
+func mapToPreviousSlotDto(e model.Event) *slot.SlotResponseDto {
+	previousSlot := e.GetPreviousSlot()
+	if previousSlot == nil {
+		return nil
+	}
+	dto := slot.MapToSlotResponseDto(*previousSlot)
+	return &dto
+}
+
 // mapToOwnerDto maps an Account to EventOwnerDto, with optional color override
 func mapToOwnerDto(account model.Account, colorOverride *string) EventOwnerDto {
 	color := account.Color
@@ -33,9 +44,11 @@ func mapToParticipantDto(ae model.AccountEvent) EventParticipantDto {
 		color = *ae.Color
 	}
 	return EventParticipantDto{
+		Id:        ae.AccountId,
 		UserName:  ae.Account.UserName,
 		AvatarUrl: ae.Account.AvatarUrl,
 		Color:     color,
+		IsGuest:   ae.Account.IsGuest,
 	}
 }
 
@@ -45,7 +58,7 @@ func MapToEventListItemDto(e model.Event) EventListItemDto {
 		Id:                  e.Id,
 		Name:                e.Name,
 		Description:         e.Description,
-		EventDurationFields: durationToFields(e.Duration),
+		EventDurationFields: DurationToFields(e.Duration),
 		StartsAt:            e.StartsAt,
 		EndsAt:              e.EndsAt,
 		Status:              e.Status,
@@ -58,10 +71,12 @@ func MapToEventCreateResponseDto(e model.Event) EventCreateResponseDto {
 		Id:                  e.Id,
 		Name:                e.Name,
 		Description:         e.Description,
-		EventDurationFields: durationToFields(e.Duration),
+		EventDurationFields: DurationToFields(e.Duration),
 		StartsAt:            e.StartsAt,
 		EndsAt:              e.EndsAt,
 		Status:              e.Status,
+		InviteOnly:          e.InviteOnly,
+		RequiresApproval:    e.RequiresApproval,
 		Owner:               mapToOwnerDto(e.Owner, nil),
 	}
 }
@@ -72,10 +87,21 @@ func MapToEventBasicResponseDto(e model.Event) EventBasicResponseDto {
 		Id:                  e.Id,
 		Name:                e.Name,
 		Description:         e.Description,
-		EventDurationFields: durationToFields(e.Duration),
+		EventDurationFields: DurationToFields(e.Duration),
 		StartsAt:            e.StartsAt,
 		EndsAt:              e.EndsAt,
 		Status:              e.Status,
+		InviteOnly:          e.InviteOnly,
+		RequiresApproval:    e.RequiresApproval,
+		CancelledAt:         e.CancelledAt,
+		CancelReason:        e.CancelReason,
+		Address:             e.Address,
+		MeetingUrl:          e.MeetingUrl,
+		DecisionDeadline:    e.DecisionDeadline,
+		MaxParticipants:     e.MaxParticipants,
+		PreviousSlot:        mapToPreviousSlotDto(e),
+		RescheduledAt:       e.RescheduledAt,
+		Version:             e.Version,
 	}
 }
 
@@ -83,7 +109,9 @@ func MapToEventBasicResponseDto(e model.Event) EventBasicResponseDto {
 func MapToEventFullResponseDto(e model.Event) EventFullResponseDto {
 	participants := make([]EventParticipantDto, 0, len(e.AccountEvents))
 	for _, ae := range e.AccountEvents {
-		participants = append(participants, mapToParticipantDto(ae))
+		participant := mapToParticipantDto(ae)
+		participant.Role, _ = e.RoleOf(&ae.AccountId)
+		participants = append(participants, participant)
 	}
 
 	availabilities := e.Availabilities
@@ -102,11 +130,25 @@ func MapToEventFullResponseDto(e model.Event) EventFullResponseDto {
 		Id:                  e.Id,
 		Name:                e.Name,
 		Description:         e.Description,
-		EventDurationFields: durationToFields(e.Duration),
+		EventDurationFields: DurationToFields(e.Duration),
 		StartsAt:            e.StartsAt,
 		EndsAt:              e.EndsAt,
 		Status:              e.Status,
+		InviteOnly:          e.InviteOnly,
+		RequiresApproval:    e.RequiresApproval,
+		CancelledAt:         e.CancelledAt,
+		CancelReason:        e.CancelReason,
+		Address:             e.Address,
+		MeetingUrl:          e.MeetingUrl,
+		DecisionDeadline:    e.DecisionDeadline,
+		MaxParticipants:     e.MaxParticipants,
+		PreviousSlot:        mapToPreviousSlotDto(e),
+		RescheduledAt:       e.RescheduledAt,
+		Version:             e.Version,
+		AutoMeetingLink:     e.AutoMeetingLink,
+		AutoConfirm:         e.AutoConfirm,
 		Owner:               mapToOwnerDto(e.Owner, nil),
+		PendingOwnerId:      e.PendingOwnerId,
 		Participants:        participants,
 		Availabilities:      availabilities,
 		Slots:               slots,
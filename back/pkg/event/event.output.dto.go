@@ -2,7 +2,9 @@ package event
 
 import (
 	"app/commons/constants"
+	"app/commons/lib"
 	model "app/db/models"
+	"app/pkg/slot"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,9 +26,12 @@ type EventOwnerDto struct {
 
 // EventParticipantDto - participant with event-specific color
 type EventParticipantDto struct {
-	UserName  *string `json:"userName"`
-	AvatarUrl string  `json:"avatarUrl"`
-	Color     string  `json:"color"`
+	Id        uuid.UUID           `json:"id"`
+	UserName  *string             `json:"userName"`
+	AvatarUrl string              `json:"avatarUrl"`
+	Color     string              `json:"color"`
+	IsGuest   bool                `json:"isGuest"`
+	Role      constants.EventRole `json:"role"`
 }
 
 // EventListItemDto - GET /events (paginated, no joins)
@@ -40,16 +45,24 @@ type EventListItemDto struct {
 	Status   constants.EventStatus `json:"status"`
 }
 
+// EventListResponseDto - GET /events (paginated, with the number of events per status ignoring the status filter)
+type EventListResponseDto struct {
+	lib.Pagination[EventListItemDto]
+	StatusCounts map[constants.EventStatus]int64 `json:"statusCounts"`
+}
+
 // EventCreateResponseDto - POST /events (event + owner)
 type EventCreateResponseDto struct {
 	Id          uuid.UUID            `json:"id"`
 	Name        string               `json:"name"`
 	Description *string              `json:"description"`
 	EventDurationFields
-	StartsAt time.Time            `json:"startsAt"`
-	EndsAt   time.Time            `json:"endsAt"`
-	Status   constants.EventStatus `json:"status"`
-	Owner    EventOwnerDto        `json:"owner"`
+	StartsAt         time.Time             `json:"startsAt"`
+	EndsAt           time.Time             `json:"endsAt"`
+	Status           constants.EventStatus `json:"status"`
+	InviteOnly       bool                  `json:"inviteOnly"`
+	RequiresApproval bool                  `json:"requiresApproval"`
+	Owner            EventOwnerDto         `json:"owner"`
 }
 
 // EventBasicResponseDto - GET /events/:id/summary (public)
@@ -58,9 +71,20 @@ type EventBasicResponseDto struct {
 	Name        string               `json:"name"`
 	Description *string              `json:"description"`
 	EventDurationFields
-	StartsAt time.Time            `json:"startsAt"`
-	EndsAt   time.Time            `json:"endsAt"`
-	Status   constants.EventStatus `json:"status"`
+	StartsAt         time.Time             `json:"startsAt"`
+	EndsAt           time.Time             `json:"endsAt"`
+	Status           constants.EventStatus `json:"status"`
+	InviteOnly       bool                  `json:"inviteOnly"`
+	RequiresApproval bool                  `json:"requiresApproval"`
+	CancelledAt      *time.Time            `json:"cancelledAt"`
+	CancelReason     *string               `json:"cancelReason"`
+	Address          *string               `json:"address"`
+	MeetingUrl       *string               `json:"meetingUrl"`
+	DecisionDeadline *time.Time            `json:"decisionDeadline"`
+	MaxParticipants  *int                  `json:"maxParticipants"`
+	PreviousSlot     *slot.SlotResponseDto `json:"previousSlot"`
+	RescheduledAt    *time.Time            `json:"rescheduledAt"`
+	Version          int                   `json:"version"` // Sent back in If-Match to update the event
 }
 
 // EventFullResponseDto - GET /events/:id (member) and POST /events/:id/join
@@ -69,11 +93,31 @@ type EventFullResponseDto struct {
 	Name        string               `json:"name"`
 	Description *string              `json:"description"`
 	EventDurationFields
-	StartsAt       time.Time             `json:"startsAt"`
-	EndsAt         time.Time             `json:"endsAt"`
-	Status         constants.EventStatus `json:"status"`
-	Owner          EventOwnerDto         `json:"owner"`
-	Participants   []EventParticipantDto `json:"participants"`
-	Availabilities []model.Availability  `json:"availabilities"`
-	Slots          []model.Slot          `json:"slots"`
+	StartsAt         time.Time             `json:"startsAt"`
+	EndsAt           time.Time             `json:"endsAt"`
+	Status           constants.EventStatus `json:"status"`
+	InviteOnly       bool                  `json:"inviteOnly"`
+	RequiresApproval bool                  `json:"requiresApproval"`
+	CancelledAt      *time.Time            `json:"cancelledAt"`
+	CancelReason     *string               `json:"cancelReason"`
+	Address          *string               `json:"address"`
+	MeetingUrl       *string               `json:"meetingUrl"`
+	DecisionDeadline *time.Time            `json:"decisionDeadline"`
+	MaxParticipants  *int                  `json:"maxParticipants"`
+	PreviousSlot     *slot.SlotResponseDto `json:"previousSlot"`
+	RescheduledAt    *time.Time            `json:"rescheduledAt"`
+	Version          int                   `json:"version"` // Sent back in If-Match to update the event
+	AutoMeetingLink  bool                  `json:"autoMeetingLink"`
+	AutoConfirm      bool                  `json:"autoConfirm"`
+	Owner            EventOwnerDto         `json:"owner"`
+	PendingOwnerId   *uuid.UUID            `json:"pendingOwnerId"`
+	Participants     []EventParticipantDto `json:"participants"`
+	Availabilities   []model.Availability  `json:"availabilities"`
+	Slots            []model.Slot          `json:"slots"`
+}
+
+// EventParticipantLeftDto - SSE participant-left payload
+type EventParticipantLeftDto struct {
+	Id      uuid.UUID `json:"id"`
+	Removed bool      `json:"removed"`
 }
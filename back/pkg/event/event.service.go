@@ -7,25 +7,38 @@ import (
 	"app/config"
 	model "app/db/models"
 	"app/db/repository"
+	"app/pkg/domainevent"
+	"app/pkg/invitation"
+	"app/pkg/joinrequest"
+	"app/pkg/lifecycle"
 	"app/pkg/mail"
 	"app/pkg/signin"
 	"app/pkg/slot"
+	"app/pkg/sse"
 	"errors"
+	mathrand "math/rand"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
 type EventService struct {
 	eventRepository        *repository.EventRepository
+	accountRepository      *repository.AccountRepository
 	accountEventRepository *repository.AccountEventRepository
 	availabilityRepository *repository.AvailabilityRepository
 	slotRepository         *repository.SlotRepository
 	slotService            *slot.SlotService
+	invitationService      *invitation.InvitationService
+	joinRequestService     *joinrequest.JoinRequestService
 	signinService          *signin.SigninService
 	mailService            *mail.MailService
+	sseService             *sse.SSEService
+	lifecycleService       *lifecycle.LifecycleService
+	domainEventBus         *domainevent.Bus
 	config                 *config.Config
 }
 
@@ -36,12 +49,18 @@ func NewEventService(service *EventService) *EventService {
 
 	return &EventService{
 		eventRepository:        repository.NewEventRepository(nil),
+		accountRepository:      repository.NewAccountRepository(nil),
 		accountEventRepository: repository.NewAccountEventRepository(nil),
 		availabilityRepository: repository.NewAvailabilityRepository(nil),
 		slotRepository:         repository.NewSlotRepository(nil),
 		slotService:            slot.NewSlotService(nil),
+		invitationService:      invitation.NewInvitationService(nil),
+		joinRequestService:     joinrequest.NewJoinRequestService(nil),
 		signinService:          signin.NewSigninService(nil),
 		mailService:            mail.NewMailService(nil),
+		sseService:             sse.GetSSEService(),
+		lifecycleService:       lifecycle.NewLifecycleService(nil),
+		domainEventBus:         domainevent.GetBus(),
 		config:                 config.GetConfig(),
 	}
 }
@@ -64,6 +83,11 @@ func (s *EventService) Create(data *EventCreateDto, user *guard.Claims) (EventCr
 		}
 	}
 
+	address, meetingUrl, err := normalizeLocation(data.Address, data.MeetingUrl)
+	if err != nil {
+		return EventCreateResponseDto{}, err
+	}
+
 	// Prevent creating events with end date before start date
 	if data.StartsAt.After(data.EndsAt) {
 		return EventCreateResponseDto{}, constants.ERR_EVENT_START_AFTER_END.Err
@@ -87,6 +111,10 @@ func (s *EventService) Create(data *EventCreateDto, user *guard.Claims) (EventCr
 		return EventCreateResponseDto{}, constants.ERR_EVENT_DURATION_TOO_SHORT.Err
 	}
 
+	if data.DecisionDeadline != nil && !isValidDecisionDeadline(*data.DecisionDeadline, data.EndsAt, time.Now()) {
+		return EventCreateResponseDto{}, constants.ERR_EVENT_INVALID_DECISION_DEADLINE.Err
+	}
+
 	// Create event
 	event := model.Event{
 		Id:          uuid.New(),
@@ -99,7 +127,15 @@ func (s *EventService) Create(data *EventCreateDto, user *guard.Claims) (EventCr
 			Id:       user.Id,
 			UserName: user.Username,
 		},
-		Status: constants.EVENT_STATUS_IN_DECISION,
+		Status:           constants.EVENT_STATUS_IN_DECISION,
+		InviteOnly:       data.InviteOnly == nil || *data.InviteOnly,
+		RequiresApproval: data.RequiresApproval,
+		Address:          address,
+		MeetingUrl:       meetingUrl,
+		AutoMeetingLink:  data.AutoMeetingLink,
+		AutoConfirm:      data.AutoConfirm,
+		DecisionDeadline: data.DecisionDeadline,
+		MaxParticipants:  data.MaxParticipants,
 	}
 	if err := s.eventRepository.Create(&event); err != nil {
 		return EventCreateResponseDto{}, err
@@ -109,6 +145,7 @@ func (s *EventService) Create(data *EventCreateDto, user *guard.Claims) (EventCr
 	accountEvent := model.AccountEvent{
 		AccountId: user.Id,
 		EventId:   event.Id,
+		Role:      constants.EVENT_ROLE_OWNER,
 	}
 	if err := s.accountEventRepository.Create(&accountEvent); err != nil {
 		_ = s.eventRepository.Delete(event.Id)
@@ -123,6 +160,31 @@ func (s *EventService) Create(data *EventCreateDto, user *guard.Claims) (EventCr
 	return MapToEventCreateResponseDto(event), nil
 }
 
+// normalizeLocation trims the location fields, empty values become nil, and checks the meeting link is an http(s) URL
+func normalizeLocation(address, meetingUrl *string) (*string, *string, error) {
+	var normalizedAddress, normalizedMeetingUrl *string
+	if address != nil {
+		if trimmed := strings.TrimSpace(*address); len(trimmed) > 0 {
+			normalizedAddress = &trimmed
+		}
+	}
+	if meetingUrl != nil {
+		if trimmed := strings.TrimSpace(*meetingUrl); len(trimmed) > 0 {
+			if !lib.IsValidHttpUrl(trimmed) {
+				return nil, nil, constants.ERR_EVENT_INVALID_MEETING_URL.Err
+			}
+			normalizedMeetingUrl = &trimmed
+		}
+	}
+
+	return normalizedAddress, normalizedMeetingUrl, nil
+}
+
+// isValidDecisionDeadline checks that the decision deadline is in the future and before the end of the event
+func isValidDecisionDeadline(deadline time.Time, eventEndsAt time.Time, now time.Time) bool {
+	return deadline.After(now) && deadline.Before(eventEndsAt)
+}
+
 // SetEventDatesFromDto validates and sets the event dates from the provided DTO values.
 func SetEventDatesFromDto(event *model.Event, startsAtDto, endsAtDto *time.Time) error {
 	if event == nil {
@@ -170,7 +232,9 @@ func SetEventDatesFromDto(event *model.Event, startsAtDto, endsAtDto *time.Time)
 	return nil
 }
 
-func (s *EventService) Update(eventId uuid.UUID, data *EventUpdateDto, user *guard.Claims) error {
+// Update applies the provided fields to the event. When expectedVersion is set, the update only goes through
+// if the event is still at that version, otherwise ERR_EVENT_VERSION_MISMATCH is returned.
+func (s *EventService) Update(eventId uuid.UUID, data *EventUpdateDto, expectedVersion *int, user *guard.Claims) error {
 	// Get event
 	var event model.Event
 	if err := s.eventRepository.FindOneById(eventId, &event); err != nil {
@@ -180,10 +244,14 @@ func (s *EventService) Update(eventId uuid.UUID, data *EventUpdateDto, user *gua
 		return err
 	}
 
-	// Check if user is the owner of the event
-	if !event.IsOwner(&user.Id) {
+	// Check if user can manage the event
+	if !event.Can(&user.Id, constants.EVENT_PERMISSION_MANAGE) {
 		return constants.ERR_EVENT_ACCESS_DENIED.Err
 	}
+	if event.Status == constants.EVENT_STATUS_CANCELLED {
+		return constants.ERR_EVENT_CANCELLED.Err
+	}
+	before := domainevent.FieldsOf(event)
 
 	// Data validation
 	if data.Name != nil {
@@ -198,6 +266,10 @@ func (s *EventService) Update(eventId uuid.UUID, data *EventUpdateDto, user *gua
 			data.Description = nil
 		}
 	}
+	address, meetingUrl, err := normalizeLocation(data.Address, data.MeetingUrl)
+	if err != nil {
+		return err
+	}
 	var isBreakingSlots bool
 	if data.StartsAt != nil || data.EndsAt != nil {
 		if err := SetEventDatesFromDto(&event, data.StartsAt, data.EndsAt); err != nil {
@@ -205,17 +277,25 @@ func (s *EventService) Update(eventId uuid.UUID, data *EventUpdateDto, user *gua
 		}
 		isBreakingSlots = true
 	}
+	if data.DecisionDeadline != nil && !isValidDecisionDeadline(*data.DecisionDeadline, event.EndsAt, time.Now()) {
+		return constants.ERR_EVENT_INVALID_DECISION_DEADLINE.Err
+	}
 
-	// Update fields if provided
+	// Only the provided fields are written, including the false and nil values that clear a setting
+	columns := map[string]any{}
 	if data.Name != nil {
-		event.Name = *data.Name
+		columns["name"] = *data.Name
 	}
 	if data.Description != nil {
-		event.Description = data.Description
+		columns["description"] = data.Description
+	}
+	if data.StartsAt != nil || data.EndsAt != nil {
+		columns["starts_at"] = event.StartsAt
+		columns["ends_at"] = event.EndsAt
 	}
 	// If any duration field is provided, recompute from current duration to preserve unset fields
 	if data.Days != nil || data.Hours != nil || data.Minutes != nil {
-		current := durationToFields(event.Duration)
+		current := DurationToFields(event.Duration)
 		days, hours, minutes := current.Days, current.Hours, current.Minutes
 		if data.Days != nil {
 			days = *data.Days
@@ -230,17 +310,65 @@ func (s *EventService) Update(eventId uuid.UUID, data *EventUpdateDto, user *gua
 		if duration < 15 || duration > 30240 {
 			return constants.ERR_EVENT_DURATION_TOO_SHORT.Err
 		}
-		event.Duration = duration
+		columns["duration"] = duration
 		isBreakingSlots = true
 	}
+	if data.InviteOnly != nil {
+		columns["invite_only"] = *data.InviteOnly
+	}
+	if data.RequiresApproval != nil {
+		columns["requires_approval"] = *data.RequiresApproval
+	}
+	if data.Address != nil {
+		columns["address"] = address
+	}
+	if data.MeetingUrl != nil {
+		columns["meeting_url"] = meetingUrl
+	}
+	if data.AutoMeetingLink != nil {
+		columns["auto_meeting_link"] = *data.AutoMeetingLink
+	}
+	if data.AutoConfirm != nil {
+		columns["auto_confirm"] = *data.AutoConfirm
+	}
+	if data.DecisionDeadline != nil || data.RemoveDecisionDeadline {
+		var deadline *time.Time
+		if !data.RemoveDecisionDeadline {
+			deadline = data.DecisionDeadline
+		}
+		columns["decision_deadline"] = deadline
+		columns["deadline_reminded_at"] = nil
+		columns["deadline_processed_at"] = nil
+	}
+	if data.MaxParticipants != nil || data.RemoveMaxParticipants {
+		var maxParticipants *int
+		if !data.RemoveMaxParticipants {
+			maxParticipants = data.MaxParticipants
+		}
+		columns["max_participants"] = maxParticipants
+	}
 
-	// Update event in repository
-	if err := s.eventRepository.Updates(&event); err != nil {
+	// The version check and the changes go in the same statement, so that only one of the writers
+	// who read the expected version gets through and the version is incremented once
+	if err := s.eventRepository.UpdateColumnsAtVersion(&event, columns, expectedVersion); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_VERSION_MISMATCH.Err
+		}
 		return err
 	}
+	s.domainEventBus.Publish(domainevent.NewChange(constants.DOMAIN_EVENT_EVENT_UPDATED, event.Id, &user.Id, before, domainevent.FieldsOf(event)))
+
+	// A lower capacity keeps the current members, a higher one lets waitlisted users in
+	if data.MaxParticipants != nil || data.RemoveMaxParticipants {
+		s.joinRequestService.PromoteWaitlisted(&event)
+	}
 
 	// If dates are not being updated, return
 	if !isBreakingSlots {
+		// Enabling the auto-confirmation may confirm the current slot right away
+		if data.AutoConfirm != nil && *data.AutoConfirm {
+			go s.slotService.LoadSlots(eventId)
+		}
 		return nil
 	}
 
@@ -260,29 +388,67 @@ func (s *EventService) Update(eventId uuid.UUID, data *EventUpdateDto, user *gua
 	return nil
 }
 
+// GetUserEvents lists the events of the user matching the query, with the number of events per status.
+// In cursor mode the page starts after the cursor and the events are not counted.
 func (s *EventService) GetUserEvents(
 	user *guard.Claims,
-	pagination *lib.Pagination[EventListItemDto],
+	query *EventListQueryDto,
+	result *EventListResponseDto,
 ) error {
-	events, total, err := s.eventRepository.FindEventsByAccountId(user.Id, pagination.Limit, pagination.Offset)
+	filter, err := listFilterFromQuery(query)
 	if err != nil {
 		return err
 	}
-	pagination.Total = total
+
+	events, err := lib.LoadPage(
+		&result.Pagination,
+		func() (int64, error) { return s.eventRepository.CountEventsByAccountId(user.Id, filter) },
+		func(after *repository.EventListCursor, limit int, offset int) ([]model.Event, error) {
+			filter.After = after
+			return s.eventRepository.FindEventsByAccountId(user.Id, filter, limit, offset)
+		},
+		repository.NewEventListCursor,
+	)
+	if err != nil {
+		return err
+	}
+
+	statusCounts, err := s.eventRepository.CountEventsByStatus(user.Id, filter)
+	if err != nil {
+		return err
+	}
+	result.StatusCounts = statusCounts
 
 	dtos := make([]EventListItemDto, 0, len(events))
 	for i := range events {
 		// Update event status if needed
-		if _, err := events[i].CheckAndAutoUpdateStatus(s.eventRepository.Updates, nil); err != nil {
+		if _, err := events[i].CheckAndAutoUpdateStatus(s.lifecycleService.Finish, nil); err != nil {
 			return err
 		}
 		dtos = append(dtos, MapToEventListItemDto(events[i]))
 	}
-	pagination.Data = dtos
+	result.Data = dtos
 
 	return nil
 }
 
+// listFilterFromQuery checks the query parameters of the event list and converts them to a repository filter
+func listFilterFromQuery(query *EventListQueryDto) (repository.EventListFilter, error) {
+	if query.From != nil && query.To != nil && query.From.After(*query.To) {
+		return repository.EventListFilter{}, constants.ERR_EVENT_INVALID_LIST_FILTER.Err
+	}
+
+	return repository.EventListFilter{
+		Statuses:  query.Status,
+		Ownership: query.Ownership,
+		From:      query.From,
+		To:        query.To,
+		Search:    strings.TrimSpace(query.Search),
+		Sort:      query.Sort,
+		Desc:      query.Order == "desc",
+	}, nil
+}
+
 // GetEventSummary returns basic event info, accessible without authentication
 func (s *EventService) GetEventSummary(eventId uuid.UUID) (EventBasicResponseDto, error) {
 	var event model.Event
@@ -293,7 +459,7 @@ func (s *EventService) GetEventSummary(eventId uuid.UUID) (EventBasicResponseDto
 		return EventBasicResponseDto{}, err
 	}
 
-	if _, err := event.CheckAndAutoUpdateStatus(s.eventRepository.Updates, nil); err != nil {
+	if _, err := event.CheckAndAutoUpdateStatus(s.lifecycleService.Finish, nil); err != nil {
 		return EventBasicResponseDto{}, err
 	}
 
@@ -310,30 +476,32 @@ func (s *EventService) GetEvent(eventId uuid.UUID, user *guard.Claims) (EventFul
 		return EventFullResponseDto{}, err
 	}
 
-	if _, err := event.CheckAndAutoUpdateStatus(s.eventRepository.Updates, nil); err != nil {
+	if _, err := event.CheckAndAutoUpdateStatus(s.lifecycleService.Finish, nil); err != nil {
 		return EventFullResponseDto{}, err
 	}
 
-	var accountEvent model.AccountEvent
-	err := s.accountEventRepository.FindByAccountAndEventId(user.Id, event.Id, &accountEvent)
-	if errors.Is(err, gorm.ErrRecordNotFound) {
+	if !event.Can(&user.Id, constants.EVENT_PERMISSION_VIEW) {
+		// Let users waiting for approval know why they cannot see the event yet
+		if err := s.joinRequestService.CheckPending(event.Id, user.Id); err != nil {
+			return EventFullResponseDto{}, err
+		}
 		return EventFullResponseDto{}, constants.ERR_EVENT_NOT_FOUND.Err
 	}
-	if err != nil {
-		return EventFullResponseDto{}, err
-	}
 
 	return MapToEventFullResponseDto(event), nil
 }
 
-func (s *EventService) JoinEvent(eventId uuid.UUID, user *guard.Claims) (EventFullResponseDto, error) {
+// JoinEvent adds the user to the event. Invite-only events require a valid invitation token,
+// and the use of the invitation is recorded with the membership.
+// When the event requires approval, a join request is created for the owner instead and returned.
+func (s *EventService) JoinEvent(eventId uuid.UUID, invitationToken *string, user *guard.Claims) (EventFullResponseDto, *joinrequest.JoinRequestResponseDto, error) {
 	// Get event
 	var event model.Event
 	if err := s.eventRepository.FindOneById(eventId, &event); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return EventFullResponseDto{}, constants.ERR_EVENT_NOT_FOUND.Err
+			return EventFullResponseDto{}, nil, constants.ERR_EVENT_NOT_FOUND.Err
 		}
-		return EventFullResponseDto{}, err
+		return EventFullResponseDto{}, nil, err
 	}
 
 	// Check if user already joined the event
@@ -341,18 +509,46 @@ func (s *EventService) JoinEvent(eventId uuid.UUID, user *guard.Claims) (EventFu
 	err := s.accountEventRepository.FindByAccountAndEventId(user.Id, event.Id, &accountEvent)
 	alreadyJoined := err == nil
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return EventFullResponseDto{}, err
+		return EventFullResponseDto{}, nil, err
 	}
 	if alreadyJoined {
-		return EventFullResponseDto{}, constants.ERR_EVENT_ALREADY_JOINED.Err
+		return EventFullResponseDto{}, nil, constants.ERR_EVENT_ALREADY_JOINED.Err
+	}
+	if err := s.joinRequestService.CheckWaitlisted(event.Id, user.Id); err != nil {
+		return EventFullResponseDto{}, nil, err
 	}
 
 	// Check and update event status if needed
-	if hasStatus, err := event.CheckAndAutoUpdateStatus(s.eventRepository.Updates, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION, constants.EVENT_STATUS_UPCOMING}); !hasStatus || err != nil {
+	if hasStatus, err := event.CheckAndAutoUpdateStatus(s.lifecycleService.Finish, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION, constants.EVENT_STATUS_UPCOMING}); !hasStatus || err != nil {
 		if err != nil {
-			return EventFullResponseDto{}, err
+			return EventFullResponseDto{}, nil, err
 		}
-		return EventFullResponseDto{}, constants.ERR_EVENT_ENDED.Err
+		return EventFullResponseDto{}, nil, event.LockedError()
+	}
+
+	// Check the invitation before creating the membership
+	invitation, err := s.invitationService.Resolve(&event, invitationToken)
+	if err != nil {
+		return EventFullResponseDto{}, nil, err
+	}
+
+	// Events requiring approval get a join request instead of a membership
+	if event.RequiresApproval {
+		if err := s.joinRequestService.CheckCanRequest(event.Id, user.Id); err != nil {
+			return EventFullResponseDto{}, nil, err
+		}
+
+		// The use of the invitation is recorded with the request
+		var invitationId *uuid.UUID
+		if invitation != nil {
+			invitationId = &invitation.Id
+		}
+
+		joinRequest, err := s.joinRequestService.Request(&event, user, invitationId)
+		if err != nil {
+			return EventFullResponseDto{}, nil, err
+		}
+		return EventFullResponseDto{}, &joinRequest, nil
 	}
 
 	// Create account_event relation
@@ -360,16 +556,116 @@ func (s *EventService) JoinEvent(eventId uuid.UUID, user *guard.Claims) (EventFu
 		AccountId: user.Id,
 		EventId:   event.Id,
 	}
-	if err := s.accountEventRepository.Create(&accountEvent); err != nil {
-		return EventFullResponseDto{}, err
+	if invitation != nil {
+		err = s.invitationService.Join(invitation, &accountEvent)
+	} else {
+		err = s.accountEventRepository.Join(&accountEvent)
 	}
+	if errors.Is(err, constants.ERR_EVENT_FULL.Err) {
+		return s.joinWaitlist(&event, invitation, user)
+	}
+	if err != nil {
+		return EventFullResponseDto{}, nil, err
+	}
+	s.domainEventBus.Publish(domainevent.NewMemberJoined(event.Id, user.Id, constants.EVENT_ROLE_PARTICIPANT, &user.Id))
 
 	// Reload event with all relations
 	if err := s.eventRepository.FindOneById(event.Id, &event); err != nil {
-		return EventFullResponseDto{}, err
+		return EventFullResponseDto{}, nil, err
 	}
 
-	return MapToEventFullResponseDto(event), nil
+	return MapToEventFullResponseDto(event), nil, nil
+}
+
+// joinWaitlist puts the user on the waitlist of the full event, the use of the invitation is recorded with the request
+func (s *EventService) joinWaitlist(event *model.Event, invitation *model.EventInvitation, user *guard.Claims) (EventFullResponseDto, *joinrequest.JoinRequestResponseDto, error) {
+	var invitationId *uuid.UUID
+	if invitation != nil {
+		invitationId = &invitation.Id
+	}
+
+	joinRequest, err := s.joinRequestService.Waitlist(event, user, invitationId)
+	if err != nil {
+		return EventFullResponseDto{}, nil, err
+	}
+
+	return EventFullResponseDto{}, &joinRequest, nil
+}
+
+// JoinAsGuest lets a visitor without an account join the event with a display name.
+// A guest account is created unless the visitor already holds a guest token, and a fresh guest token is returned.
+// When the event requires approval, the guest keeps the account and the pending join request is returned.
+func (s *EventService) JoinAsGuest(eventId uuid.UUID, data *EventGuestJoinDto, user *guard.Claims) (EventFullResponseDto, *joinrequest.JoinRequestResponseDto, string, error) {
+	if user != nil && !user.Guest {
+		return EventFullResponseDto{}, nil, "", constants.ERR_ALREADY_AUTHENTICATED.Err
+	}
+
+	createdGuest := false
+	if user == nil {
+		guest, err := s.createGuestAccount(data)
+		if err != nil {
+			return EventFullResponseDto{}, nil, "", err
+		}
+		user = &guard.Claims{
+			Id:       guest.Id,
+			Username: guest.UserName,
+		}
+		createdGuest = true
+	}
+
+	result, joinRequest, err := s.JoinEvent(eventId, data.InvitationToken, user)
+	if err != nil {
+		// Do not keep a guest account that belongs to no event
+		if createdGuest {
+			_ = s.accountRepository.Delete(user.Id)
+		}
+		return EventFullResponseDto{}, nil, "", err
+	}
+
+	token, err := guard.GenerateGuestToken(user)
+	if err != nil {
+		return EventFullResponseDto{}, nil, "", err
+	}
+
+	return result, joinRequest, token, nil
+}
+
+// createGuestAccount creates an account identified only by a display name
+func (s *EventService) createGuestAccount(data *EventGuestJoinDto) (model.Account, error) {
+	displayName := strings.TrimSpace(data.DisplayName)
+	if len(displayName) < 3 {
+		return model.Account{}, errors.New("display name must be at least 3 characters long")
+	}
+
+	language := data.Language
+	if language == "" {
+		language = constants.ACCOUNT_LANGUAGE_EN
+	}
+
+	timeZone := time.UTC
+	if data.TimeZone != "" {
+		loc, err := time.LoadLocation(data.TimeZone)
+		if err != nil {
+			return model.Account{}, errors.New("invalid time zone")
+		}
+		timeZone = loc
+	}
+
+	color := constants.COLORS[mathrand.Intn(len(constants.COLORS))]
+
+	var account model.Account
+	if err := s.accountRepository.Create(repository.AccountCreateDto{
+		Id:       uuid.New(),
+		UserName: &displayName,
+		Color:    string(color),
+		Language: language,
+		TimeZone: *timeZone,
+		IsGuest:  true,
+	}, &account); err != nil {
+		return model.Account{}, err
+	}
+
+	return account, nil
 }
 
 func (s *EventService) UpdateProfile(data *EventProfileDto, eventId uuid.UUID, user *guard.Claims) error {
@@ -394,3 +690,331 @@ func (s *EventService) UpdateProfile(data *EventProfileDto, eventId uuid.UUID, u
 
 	return nil
 }
+
+// UpdateParticipantRole changes the role of a member. Only the owner can change roles, and the owner role cannot be given nor taken here.
+func (s *EventService) UpdateParticipantRole(eventId uuid.UUID, participantId uuid.UUID, data *EventRoleUpdateDto, user *guard.Claims) (EventParticipantDto, error) {
+	var event model.Event
+	if err := s.eventRepository.FindOneById(eventId, &event); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return EventParticipantDto{}, constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return EventParticipantDto{}, err
+	}
+
+	if !event.Can(&user.Id, constants.EVENT_PERMISSION_ADMINISTER) {
+		return EventParticipantDto{}, constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+
+	role, isMember := event.RoleOf(&participantId)
+	if !isMember {
+		return EventParticipantDto{}, constants.ERR_EVENT_PARTICIPANT_NOT_FOUND.Err
+	}
+	if role == constants.EVENT_ROLE_OWNER {
+		return EventParticipantDto{}, constants.ERR_EVENT_OWNER_ROLE_LOCKED.Err
+	}
+
+	accountEvent := model.AccountEvent{
+		AccountId: participantId,
+		EventId:   event.Id,
+		Role:      data.Role,
+	}
+	if err := s.accountEventRepository.Updates(&accountEvent); err != nil {
+		return EventParticipantDto{}, err
+	}
+
+	// Availabilities of viewers no longer count, and promoted viewers count again
+	if role.Grants(constants.EVENT_PERMISSION_CONTRIBUTE) != data.Role.Grants(constants.EVENT_PERMISSION_CONTRIBUTE) {
+		go s.slotService.LoadSlots(event.Id)
+	}
+
+	var participant model.AccountEvent
+	if err := s.accountEventRepository.FindByAccountAndEventId(participantId, event.Id, &participant); err != nil {
+		return EventParticipantDto{}, err
+	}
+
+	result := mapToParticipantDto(participant)
+	result.Role = data.Role
+
+	return result, nil
+}
+
+// Clone creates a new event from an existing one, shifted to start at the given date.
+// The description, duration and settings are copied, the participants only when asked, and they are told by email.
+func (s *EventService) Clone(eventId uuid.UUID, data *EventCloneDto, user *guard.Claims) (EventCreateResponseDto, error) {
+	var source model.Event
+	if err := s.eventRepository.FindOneById(eventId, &source); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return EventCreateResponseDto{}, constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return EventCreateResponseDto{}, err
+	}
+
+	if !source.Can(&user.Id, constants.EVENT_PERMISSION_MANAGE) {
+		return EventCreateResponseDto{}, constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+
+	createDto := cloneCreateDto(source, data.StartsAt, time.Now())
+	if data.Name != nil {
+		createDto.Name = *data.Name
+	}
+
+	created, err := s.Create(&createDto, user)
+	if err != nil {
+		return EventCreateResponseDto{}, err
+	}
+
+	if data.WithParticipants {
+		s.cloneParticipants(source, created.Id, user.Id)
+	}
+
+	return created, nil
+}
+
+// cloneCreateDto builds the creation data of a copy of the event starting at startsAt.
+// The decision deadline is shifted as well and dropped when it would not be valid anymore.
+func cloneCreateDto(source model.Event, startsAt time.Time, now time.Time) EventCreateDto {
+	shift := startsAt.Sub(source.StartsAt)
+	duration := DurationToFields(source.Duration)
+
+	dto := EventCreateDto{
+		Name:             source.Name,
+		Description:      lib.CopyString(source.Description),
+		Days:             duration.Days,
+		Hours:            duration.Hours,
+		Minutes:          duration.Minutes,
+		StartsAt:         startsAt,
+		EndsAt:           source.EndsAt.Add(shift),
+		InviteOnly:       &source.InviteOnly,
+		RequiresApproval: source.RequiresApproval,
+		Address:          lib.CopyString(source.Address),
+		AutoMeetingLink:  source.AutoMeetingLink,
+		AutoConfirm:      source.AutoConfirm,
+		MaxParticipants:  source.MaxParticipants,
+	}
+
+	// A generated meeting link belongs to the source event, the copy gets its own on confirmation
+	if !source.AutoMeetingLink {
+		dto.MeetingUrl = lib.CopyString(source.MeetingUrl)
+	}
+
+	if source.DecisionDeadline != nil {
+		deadline := source.DecisionDeadline.Add(shift)
+		if isValidDecisionDeadline(deadline, dto.EndsAt, now) {
+			dto.DecisionDeadline = &deadline
+		}
+	}
+
+	return dto
+}
+
+// cloneParticipants adds the members of the source event to the new one with the same role and invites them by email.
+// Guests are not copied as their account only exists for the source event, the former owner becomes organiser.
+func (s *EventService) cloneParticipants(source model.Event, eventId uuid.UUID, ownerId uuid.UUID) {
+	var participants []model.Account
+	for _, accountEvent := range source.AccountEvents {
+		if accountEvent.AccountId == ownerId || accountEvent.Account.IsGuest {
+			continue
+		}
+
+		role := accountEvent.Role
+		if role == constants.EVENT_ROLE_OWNER {
+			role = constants.EVENT_ROLE_ORGANISER
+		}
+
+		member := model.AccountEvent{
+			AccountId: accountEvent.AccountId,
+			EventId:   eventId,
+			Role:      role,
+		}
+		if err := s.accountEventRepository.Create(&member); err != nil {
+			log.Error().Err(err).Str("eventId", eventId.String()).Msg("EVENT_SERVICE::CLONE Failed to copy participant")
+			continue
+		}
+		participants = append(participants, accountEvent.Account)
+	}
+
+	if len(participants) == 0 {
+		return
+	}
+
+	var event model.Event
+	if err := s.eventRepository.FindOneById(eventId, &event); err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("EVENT_SERVICE::CLONE Failed to get event for invitation mail")
+		return
+	}
+	for _, participant := range participants {
+		go s.mailService.SendEventInvitationEmail(participant, event)
+	}
+}
+
+// Cancel marks the event as cancelled with an optional reason and tells every other member by email.
+// Cancelled events stay visible but cannot be changed anymore.
+func (s *EventService) Cancel(eventId uuid.UUID, data *EventCancelDto, user *guard.Claims) error {
+	var event model.Event
+	if err := s.eventRepository.FindOneById(eventId, &event); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	if !event.Can(&user.Id, constants.EVENT_PERMISSION_ADMINISTER) {
+		return constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+
+	// Only open events can be cancelled
+	if hasStatus, err := event.CheckAndAutoUpdateStatus(s.lifecycleService.Finish, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION, constants.EVENT_STATUS_UPCOMING}); !hasStatus || err != nil {
+		if err != nil {
+			return err
+		}
+		return event.LockedError()
+	}
+
+	var reason *string
+	if data.Reason != nil {
+		trimmed := strings.TrimSpace(*data.Reason)
+		if len(trimmed) > 0 {
+			reason = &trimmed
+		}
+	}
+
+	cancelledAt := time.Now()
+	if err := s.eventRepository.Cancel(event.Id, reason, cancelledAt); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_CANCELLED.Err
+		}
+		return err
+	}
+	s.domainEventBus.Publish(domainevent.NewStatusChanged(event.Id, event.Status, constants.EVENT_STATUS_CANCELLED, &user.Id))
+	event.Status = constants.EVENT_STATUS_CANCELLED
+	event.CancelledAt = &cancelledAt
+	event.CancelReason = reason
+
+	s.sseService.Broadcast(event.Id, constants.SSE_EVENT_EVENT_CANCELLED, MapToEventBasicResponseDto(event))
+
+	// Send cancellation emails to all members except the one who cancelled
+	var participants []model.Account
+	if err := s.accountEventRepository.FindAccountsByEventId(event.Id, &participants); err != nil {
+		log.Error().Err(err).Str("eventId", event.Id.String()).Msg("EVENT_SERVICE::CANCEL Failed to get participants for event cancelled mail")
+		return nil
+	}
+	validatedSlot := event.GetValidatedSlot()
+	for _, participant := range participants {
+		if participant.Id == user.Id {
+			continue
+		}
+		go s.mailService.SendEventCancelledEmail(participant, event, reason, validatedSlot)
+	}
+
+	return nil
+}
+
+// Leave removes the user from the event, or from its waitlist. The owner has to transfer the ownership first.
+func (s *EventService) Leave(eventId uuid.UUID, user *guard.Claims) error {
+	var event model.Event
+	if err := s.eventRepository.FindOneById(eventId, &event); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	role, isMember := event.RoleOf(&user.Id)
+	if !isMember {
+		return s.joinRequestService.LeaveWaitlist(event.Id, user.Id)
+	}
+	if role == constants.EVENT_ROLE_OWNER {
+		return constants.ERR_EVENT_OWNER_CANNOT_LEAVE.Err
+	}
+
+	return s.removeMember(&event, user.Id, user.Id)
+}
+
+// RemoveParticipant removes a member from the event. Only the owner can remove members.
+func (s *EventService) RemoveParticipant(eventId uuid.UUID, participantId uuid.UUID, user *guard.Claims) error {
+	var event model.Event
+	if err := s.eventRepository.FindOneById(eventId, &event); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	if !event.Can(&user.Id, constants.EVENT_PERMISSION_ADMINISTER) {
+		return constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+
+	role, isMember := event.RoleOf(&participantId)
+	if !isMember {
+		return constants.ERR_EVENT_PARTICIPANT_NOT_FOUND.Err
+	}
+	if role == constants.EVENT_ROLE_OWNER {
+		return constants.ERR_EVENT_OWNER_CANNOT_LEAVE.Err
+	}
+
+	return s.removeMember(&event, participantId, user.Id)
+}
+
+// removeMember deletes the membership and availabilities of the member, gives the seat to the first waitlisted user,
+// recalculates the slots, tells the other members over SSE and warns the owner when the member was available for the validated slot
+func (s *EventService) removeMember(event *model.Event, memberId uuid.UUID, actorId uuid.UUID) error {
+	removed := memberId != actorId
+	role, _ := event.RoleOf(&memberId)
+	validatedSlot := event.GetValidatedSlot()
+	wasInValidatedSlot := validatedSlot != nil && hasAvailabilityDuring(event.Availabilities, memberId, validatedSlot)
+
+	if err := s.accountEventRepository.Delete(memberId, event.Id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_PARTICIPANT_NOT_FOUND.Err
+		}
+		return err
+	}
+	s.domainEventBus.Publish(domainevent.NewMemberLeft(event.Id, memberId, role, &actorId))
+
+	s.joinRequestService.PromoteWaitlisted(event)
+
+	go s.slotService.LoadSlots(event.Id)
+
+	s.sseService.DisconnectUser(event.Id, memberId)
+	s.sseService.Broadcast(event.Id, constants.SSE_EVENT_PARTICIPANT_LEFT, EventParticipantLeftDto{
+		Id:      memberId,
+		Removed: removed,
+	})
+
+	if wasInValidatedSlot {
+		s.warnOwnerParticipantLeft(event, memberId, removed, validatedSlot)
+	}
+
+	return nil
+}
+
+// hasAvailabilityDuring checks if the account has an availability overlapping the slot
+func hasAvailabilityDuring(availabilities []model.Availability, accountId uuid.UUID, slot *model.Slot) bool {
+	for _, availability := range availabilities {
+		if availability.AccountId != accountId {
+			continue
+		}
+		if availability.StartsAt.Before(slot.EndsAt) && availability.EndsAt.After(slot.StartsAt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *EventService) warnOwnerParticipantLeft(event *model.Event, memberId uuid.UUID, removed bool, validatedSlot *model.Slot) {
+	participantName := ""
+	for _, accountEvent := range event.AccountEvents {
+		if accountEvent.AccountId == memberId && accountEvent.Account.UserName != nil {
+			participantName = *accountEvent.Account.UserName
+		}
+	}
+
+	var owner model.Account
+	if err := s.accountRepository.FindOneById(event.OwnerId, &owner); err != nil {
+		log.Error().Err(err).Str("eventId", event.Id.String()).Msg("EVENT_SERVICE::REMOVE_MEMBER Failed to get owner for participant left mail")
+		return
+	}
+
+	go s.mailService.SendParticipantLeftEmail(owner, *event, participantName, removed, validatedSlot.StartsAt, validatedSlot.EndsAt)
+}
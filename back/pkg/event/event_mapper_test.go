@@ -10,7 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-// --- durationToFields ---
+// --- DurationToFields ---
 
 func TestDurationToFields(t *testing.T) {
 	tests := []struct {
@@ -28,7 +28,7 @@ func TestDurationToFields(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, durationToFields(tt.duration))
+			assert.Equal(t, tt.want, DurationToFields(tt.duration))
 		})
 	}
 }
@@ -83,6 +83,16 @@ func TestMapToParticipantDto_UsesAccountColorWhenEventColorIsEmpty(t *testing.T)
 	assert.Equal(t, "#AABBCC", dto.Color)
 }
 
+func TestMapToParticipantDto_ExposesAccountId(t *testing.T) {
+	accountId := uuid.New()
+	ae := model.AccountEvent{
+		AccountId: accountId,
+		Account:   model.Account{Id: accountId},
+	}
+	dto := mapToParticipantDto(ae)
+	assert.Equal(t, accountId, dto.Id)
+}
+
 func TestMapToParticipantDto_UsesAccountEventColorOverride(t *testing.T) {
 	override := "#112233"
 	ae := model.AccountEvent{
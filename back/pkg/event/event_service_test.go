@@ -317,3 +317,298 @@ func TestParseDtoEventDates_NilEvent(t *testing.T) {
 		assert.Equal(t, "event pointer is nil", err.Error())
 	})
 }
+
+func TestJoinAsGuest_RejectsAuthenticatedUser(t *testing.T) {
+	data := &EventGuestJoinDto{DisplayName: "Guest"}
+
+	_, joinRequest, token, err := service.JoinAsGuest(uuid.New(), data, user)
+
+	assert.Equal(t, constants.ERR_ALREADY_AUTHENTICATED.Err, err)
+	assert.Nil(t, joinRequest)
+	assert.Empty(t, token)
+}
+
+func TestCreateGuestAccount_DisplayNameTooShortAfterTrim(t *testing.T) {
+	data := &EventGuestJoinDto{DisplayName: "  ab  "}
+
+	_, err := service.createGuestAccount(data)
+
+	assert.EqualError(t, err, "display name must be at least 3 characters long")
+}
+
+func TestCreateGuestAccount_InvalidTimeZone(t *testing.T) {
+	data := &EventGuestJoinDto{DisplayName: "Guest", TimeZone: "Not/AZone"}
+
+	_, err := service.createGuestAccount(data)
+
+	assert.EqualError(t, err, "invalid time zone")
+}
+
+func TestEventCan_RolePermissions(t *testing.T) {
+	ownerId := uuid.New()
+	organiserId := uuid.New()
+	participantId := uuid.New()
+	viewerId := uuid.New()
+	outsiderId := uuid.New()
+	event := model.Event{
+		OwnerId: ownerId,
+		AccountEvents: []model.AccountEvent{
+			{AccountId: ownerId, Role: constants.EVENT_ROLE_OWNER},
+			{AccountId: organiserId, Role: constants.EVENT_ROLE_ORGANISER},
+			{AccountId: participantId},
+			{AccountId: viewerId, Role: constants.EVENT_ROLE_VIEWER},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		userId     uuid.UUID
+		view       bool
+		contribute bool
+		manage     bool
+		administer bool
+	}{
+		{"owner", ownerId, true, true, true, true},
+		{"organiser", organiserId, true, true, true, false},
+		{"participant without stored role", participantId, true, true, false, false},
+		{"viewer", viewerId, true, false, false, false},
+		{"outsider", outsiderId, false, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.view, event.Can(&tt.userId, constants.EVENT_PERMISSION_VIEW))
+			assert.Equal(t, tt.contribute, event.Can(&tt.userId, constants.EVENT_PERMISSION_CONTRIBUTE))
+			assert.Equal(t, tt.manage, event.Can(&tt.userId, constants.EVENT_PERMISSION_MANAGE))
+			assert.Equal(t, tt.administer, event.Can(&tt.userId, constants.EVENT_PERMISSION_ADMINISTER))
+		})
+	}
+}
+
+func TestEventRoleOf_StaleOwnerRoleIsParticipant(t *testing.T) {
+	formerOwnerId := uuid.New()
+	event := model.Event{
+		OwnerId:       uuid.New(),
+		AccountEvents: []model.AccountEvent{{AccountId: formerOwnerId, Role: constants.EVENT_ROLE_OWNER}},
+	}
+
+	role, isMember := event.RoleOf(&formerOwnerId)
+
+	assert.True(t, isMember)
+	assert.Equal(t, constants.EVENT_ROLE_PARTICIPANT, role)
+}
+
+func TestHasAvailabilityDuring(t *testing.T) {
+	accountId := uuid.New()
+	start := time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC)
+	slot := &model.Slot{StartsAt: start, EndsAt: start.Add(2 * time.Hour)}
+
+	tests := []struct {
+		name           string
+		availabilities []model.Availability
+		expected       bool
+	}{
+		{
+			name:           "overlapping availability",
+			availabilities: []model.Availability{{AccountId: accountId, StartsAt: start.Add(time.Hour), EndsAt: start.Add(3 * time.Hour)}},
+			expected:       true,
+		},
+		{
+			name:           "availability ending when the slot starts",
+			availabilities: []model.Availability{{AccountId: accountId, StartsAt: start.Add(-time.Hour), EndsAt: start}},
+			expected:       false,
+		},
+		{
+			name:           "overlapping availability of another member",
+			availabilities: []model.Availability{{AccountId: uuid.New(), StartsAt: start, EndsAt: start.Add(time.Hour)}},
+			expected:       false,
+		},
+		{
+			name:     "no availabilities",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, hasAvailabilityDuring(tt.availabilities, accountId, slot))
+		})
+	}
+}
+
+func TestCheckAndAutoUpdateStatus_CancelledEventStaysCancelled(t *testing.T) {
+	event := model.Event{
+		Status:   constants.EVENT_STATUS_CANCELLED,
+		StartsAt: time.Now().Add(-48 * time.Hour),
+		EndsAt:   time.Now().Add(-24 * time.Hour),
+	}
+	updated := false
+
+	hasStatus, err := event.CheckAndAutoUpdateStatus(func(*model.Event) error {
+		updated = true
+		return nil
+	}, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION, constants.EVENT_STATUS_UPCOMING})
+
+	assert.NoError(t, err)
+	assert.False(t, hasStatus)
+	assert.False(t, updated)
+	assert.Equal(t, constants.EVENT_STATUS_CANCELLED, event.Status)
+	assert.ErrorIs(t, event.LockedError(), constants.ERR_EVENT_CANCELLED.Err)
+}
+
+func TestLockedError_FinishedEvent(t *testing.T) {
+	event := model.Event{Status: constants.EVENT_STATUS_FINISHED}
+
+	assert.ErrorIs(t, event.LockedError(), constants.ERR_EVENT_ENDED.Err)
+}
+
+func TestNormalizeLocation(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name               string
+		address            *string
+		meetingUrl         *string
+		expectedAddress    *string
+		expectedMeetingUrl *string
+		expectedErr        error
+	}{
+		{
+			name:               "trims both fields",
+			address:            ptr("  12 rue de la Paix, Paris "),
+			meetingUrl:         ptr(" https://meet.example.com/team "),
+			expectedAddress:    ptr("12 rue de la Paix, Paris"),
+			expectedMeetingUrl: ptr("https://meet.example.com/team"),
+		},
+		{
+			name:       "empty values clear the fields",
+			address:    ptr("   "),
+			meetingUrl: ptr(""),
+		},
+		{
+			name: "missing fields stay nil",
+		},
+		{
+			name:        "meeting link without scheme",
+			meetingUrl:  ptr("meet.example.com/team"),
+			expectedErr: constants.ERR_EVENT_INVALID_MEETING_URL.Err,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, meetingUrl, err := normalizeLocation(tt.address, tt.meetingUrl)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedAddress, address)
+			assert.Equal(t, tt.expectedMeetingUrl, meetingUrl)
+		})
+	}
+}
+
+func TestIsValidDecisionDeadline(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	eventEndsAt := now.Add(7 * 24 * time.Hour)
+
+	assert.True(t, isValidDecisionDeadline(now.Add(24*time.Hour), eventEndsAt, now))
+	assert.False(t, isValidDecisionDeadline(now.Add(-time.Hour), eventEndsAt, now), "deadline in the past")
+	assert.False(t, isValidDecisionDeadline(eventEndsAt.Add(time.Hour), eventEndsAt, now), "deadline after the end of the event")
+}
+
+func TestCloneCreateDto(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	source := model.Event{
+		Name:             "Sprint review",
+		Description:      ptr("Demo of the sprint"),
+		Duration:         90,
+		StartsAt:         time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC),
+		EndsAt:           time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC),
+		InviteOnly:       true,
+		RequiresApproval: true,
+		Address:          ptr("Room 4"),
+		MeetingUrl:       ptr("https://meet.example.com/abc"),
+		AutoConfirm:      true,
+	}
+	startsAt := time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)
+
+	t.Run("copies the settings and shifts the dates", func(t *testing.T) {
+		deadline := time.Date(2025, 3, 6, 12, 0, 0, 0, time.UTC)
+		source := source
+		source.DecisionDeadline = &deadline
+
+		dto := cloneCreateDto(source, startsAt, now)
+
+		assert.Equal(t, source.Name, dto.Name)
+		assert.Equal(t, source.Description, dto.Description)
+		assert.Equal(t, 90, FieldsToDuration(dto.Days, dto.Hours, dto.Minutes))
+		assert.Equal(t, startsAt, dto.StartsAt)
+		assert.Equal(t, time.Date(2025, 3, 22, 0, 0, 0, 0, time.UTC), dto.EndsAt)
+		assert.True(t, *dto.InviteOnly)
+		assert.True(t, dto.RequiresApproval)
+		assert.True(t, dto.AutoConfirm)
+		assert.Equal(t, source.Address, dto.Address)
+		assert.Equal(t, source.MeetingUrl, dto.MeetingUrl)
+		assert.Equal(t, time.Date(2025, 3, 20, 12, 0, 0, 0, time.UTC), *dto.DecisionDeadline)
+	})
+
+	t.Run("generated meeting link is not copied", func(t *testing.T) {
+		source := source
+		source.AutoMeetingLink = true
+
+		dto := cloneCreateDto(source, startsAt, now)
+
+		assert.True(t, dto.AutoMeetingLink)
+		assert.Nil(t, dto.MeetingUrl)
+	})
+
+	t.Run("deadline in the past is dropped", func(t *testing.T) {
+		deadline := time.Date(2025, 3, 6, 12, 0, 0, 0, time.UTC)
+		source := source
+		source.DecisionDeadline = &deadline
+
+		dto := cloneCreateDto(source, source.StartsAt.Add(24*time.Hour), now)
+
+		assert.Nil(t, dto.DecisionDeadline)
+	})
+}
+
+func TestListFilterFromQuery(t *testing.T) {
+	from := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	to := from.Add(7 * 24 * time.Hour)
+
+	filter, err := listFilterFromQuery(&EventListQueryDto{From: &from, To: &to, Search: "  sprint ", Sort: "name", Order: "desc"})
+	assert.NoError(t, err)
+	assert.Equal(t, "sprint", filter.Search)
+	assert.Equal(t, "name", filter.Sort)
+	assert.True(t, filter.Desc)
+
+	_, err = listFilterFromQuery(&EventListQueryDto{From: &to, To: &from})
+	assert.Equal(t, constants.ERR_EVENT_INVALID_LIST_FILTER.Err, err)
+}
+
+func TestParseIfMatch(t *testing.T) {
+	for header, expected := range map[string]int{`"3"`: 3, `W/"3"`: 3, "12": 12, ` "7" `: 7} {
+		version, err := parseIfMatch(header)
+		assert.NoError(t, err, header)
+		if assert.NotNil(t, version, header) {
+			assert.Equal(t, expected, *version, header)
+		}
+	}
+
+	for _, header := range []string{"", "*"} {
+		version, err := parseIfMatch(header)
+		assert.NoError(t, err)
+		assert.Nil(t, version, "any version is accepted for %q", header)
+	}
+
+	_, err := parseIfMatch(`"abc"`)
+	assert.Error(t, err)
+
+	version, err := parseIfMatch(formatETag(5))
+	assert.NoError(t, err)
+	assert.Equal(t, 5, *version, "an ETag is a valid If-Match")
+}
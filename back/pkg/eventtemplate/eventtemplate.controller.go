@@ -0,0 +1,137 @@
+package eventtemplate
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	"app/commons/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type EventTemplateController struct {
+	templateService *EventTemplateService
+}
+
+func NewEventTemplateController(ctl *EventTemplateController) *EventTemplateController {
+	if ctl != nil {
+		return ctl
+	}
+
+	return &EventTemplateController{
+		templateService: NewEventTemplateService(nil),
+	}
+}
+
+func (ctl *EventTemplateController) getTemplateIdParam(c *gin.Context) (uuid.UUID, error) {
+	templateId, err := uuid.Parse(c.Param("templateId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_TEMPLATE_NOT_FOUND.Err)
+		return uuid.Nil, err
+	}
+
+	return templateId, nil
+}
+
+// @Summary Create an event template
+// @Description Saves the description, duration, search period length and settings of an event the user can manage as a named template.
+// @Tags Event template
+// @Accept json
+// @Produce json
+// @Param data body EventTemplateCreateDto true "Template parameters"
+// @Security BearerAuth
+// @Success 200 {object} EventTemplateResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_EVENT_ACCESS_DENIED"
+// @Router /api/v1/event-templates [post]
+func (ctl *EventTemplateController) Create(c *gin.Context) {
+	var data EventTemplateCreateDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	result, err := ctl.templateService.Create(&data, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary List event templates
+// @Description Lists the templates of the user, sorted by name.
+// @Tags Event template
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} EventTemplateResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request"
+// @Router /api/v1/event-templates [get]
+func (ctl *EventTemplateController) List(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	result, err := ctl.templateService.List(user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary Delete an event template
+// @Tags Event template
+// @Accept json
+// @Produce json
+// @Param templateId path string true "Template Id"
+// @Security BearerAuth
+// @Success 200
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_TEMPLATE_NOT_FOUND"
+// @Router /api/v1/event-templates/{templateId} [delete]
+func (ctl *EventTemplateController) Delete(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	templateId, err := ctl.getTemplateIdParam(c)
+	if err != nil {
+		return
+	}
+
+	err = ctl.templateService.Delete(templateId, user)
+	helpers.HandleJSONResponse(c, nil, err)
+}
+
+// @Summary Create an event from a template
+// @Description Creates a new event owned by the user with the settings of the template, starting at the given date.
+// @Tags Event template
+// @Accept json
+// @Produce json
+// @Param templateId path string true "Template Id"
+// @Param data body EventTemplateUseDto true "Event parameters"
+// @Security BearerAuth
+// @Success 200 {object} event.EventCreateResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_TEMPLATE_NOT_FOUND, ERR_EVENT_START_BEFORE_TODAY, ERR_EVENT_START_AFTER_END, ERR_EVENT_DURATION_TOO_SHORT, or ERR_EVENT_INVALID_MEETING_URL"
+// @Router /api/v1/event-templates/{templateId}/events [post]
+func (ctl *EventTemplateController) CreateEvent(c *gin.Context) {
+	var data EventTemplateUseDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	templateId, err := ctl.getTemplateIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.templateService.CreateEvent(templateId, &data, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
@@ -0,0 +1,22 @@
+package eventtemplate
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventTemplateCreateDto - POST /event-templates
+type EventTemplateCreateDto struct {
+	Name string `json:"name" binding:"required,min=3,max=100"`
+	// Event whose settings are saved in the template
+	EventId uuid.UUID `json:"eventId" binding:"required"`
+}
+
+// EventTemplateUseDto - POST /event-templates/:templateId/events
+type EventTemplateUseDto struct {
+	// The end of the event is deduced from the search period saved in the template
+	StartsAt time.Time `json:"startsAt" binding:"required"`
+	// Defaults to the event name saved in the template
+	Name *string `json:"name" binding:"omitempty,min=5,max=100"`
+}
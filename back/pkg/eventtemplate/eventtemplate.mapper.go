@@ -0,0 +1,72 @@
+package eventtemplate
+
+import (
+	"app/commons/lib"
+	model "app/db/models"
+	"app/pkg/event"
+	"time"
+)
+
+func MapToEventTemplateResponseDto(t model.EventTemplate) EventTemplateResponseDto {
+	return EventTemplateResponseDto{
+		Id:               t.Id,
+		Name:             t.Name,
+		EventName:        t.EventName,
+		Description:      t.Description,
+		Duration:         t.Duration,
+		Period:           t.Period,
+		InviteOnly:       t.InviteOnly,
+		RequiresApproval: t.RequiresApproval,
+		Address:          t.Address,
+		MeetingUrl:       t.MeetingUrl,
+		AutoMeetingLink:  t.AutoMeetingLink,
+		AutoConfirm:      t.AutoConfirm,
+		MaxParticipants:  t.MaxParticipants,
+		CreatedAt:        t.CreatedAt,
+	}
+}
+
+// mapEventToTemplate keeps the settings of the event, a generated meeting link is not reused
+func mapEventToTemplate(e model.Event, name string) model.EventTemplate {
+	template := model.EventTemplate{
+		Name:             name,
+		EventName:        e.Name,
+		Description:      e.Description,
+		Duration:         e.Duration,
+		Period:           int(e.EndsAt.Sub(e.StartsAt).Minutes()),
+		InviteOnly:       e.InviteOnly,
+		RequiresApproval: e.RequiresApproval,
+		Address:          e.Address,
+		AutoMeetingLink:  e.AutoMeetingLink,
+		AutoConfirm:      e.AutoConfirm,
+		MaxParticipants:  e.MaxParticipants,
+	}
+	if !e.AutoMeetingLink {
+		template.MeetingUrl = e.MeetingUrl
+	}
+
+	return template
+}
+
+// mapTemplateToEventCreateDto builds the creation data of an event starting at startsAt
+func mapTemplateToEventCreateDto(t model.EventTemplate, startsAt time.Time) event.EventCreateDto {
+	duration := event.DurationToFields(t.Duration)
+
+	// The text fields are copied as Create trims them in place
+	return event.EventCreateDto{
+		Name:             t.EventName,
+		Description:      lib.CopyString(t.Description),
+		Days:             duration.Days,
+		Hours:            duration.Hours,
+		Minutes:          duration.Minutes,
+		StartsAt:         startsAt,
+		EndsAt:           startsAt.Add(time.Duration(t.Period) * time.Minute),
+		InviteOnly:       &t.InviteOnly,
+		RequiresApproval: t.RequiresApproval,
+		Address:          lib.CopyString(t.Address),
+		MeetingUrl:       lib.CopyString(t.MeetingUrl),
+		AutoMeetingLink:  t.AutoMeetingLink,
+		AutoConfirm:      t.AutoConfirm,
+		MaxParticipants:  t.MaxParticipants,
+	}
+}
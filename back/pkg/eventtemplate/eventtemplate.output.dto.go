@@ -0,0 +1,25 @@
+package eventtemplate
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventTemplateResponseDto - POST and GET /event-templates
+type EventTemplateResponseDto struct {
+	Id               uuid.UUID `json:"id"`
+	Name             string    `json:"name"`
+	EventName        string    `json:"eventName"`
+	Description      *string   `json:"description"`
+	Duration         int       `json:"duration"`
+	Period           int       `json:"period"`
+	InviteOnly       bool      `json:"inviteOnly"`
+	RequiresApproval bool      `json:"requiresApproval"`
+	Address          *string   `json:"address"`
+	MeetingUrl       *string   `json:"meetingUrl"`
+	AutoMeetingLink  bool      `json:"autoMeetingLink"`
+	AutoConfirm      bool      `json:"autoConfirm"`
+	MaxParticipants  *int      `json:"maxParticipants"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
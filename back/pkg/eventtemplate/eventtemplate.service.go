@@ -0,0 +1,101 @@
+package eventtemplate
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	model "app/db/models"
+	"app/db/repository"
+	"app/pkg/event"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type EventTemplateService struct {
+	templateRepository *repository.EventTemplateRepository
+	eventRepository    *repository.EventRepository
+	eventService       *event.EventService
+}
+
+func NewEventTemplateService(service *EventTemplateService) *EventTemplateService {
+	if service != nil {
+		return service
+	}
+
+	return &EventTemplateService{
+		templateRepository: repository.NewEventTemplateRepository(nil),
+		eventRepository:    repository.NewEventRepository(nil),
+		eventService:       event.NewEventService(nil),
+	}
+}
+
+// Create saves the settings of an event the user can manage as a template of the user
+func (s *EventTemplateService) Create(data *EventTemplateCreateDto, user *guard.Claims) (EventTemplateResponseDto, error) {
+	var source model.Event
+	if err := s.eventRepository.FindOneById(data.EventId, &source); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return EventTemplateResponseDto{}, constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return EventTemplateResponseDto{}, err
+	}
+
+	if !source.Can(&user.Id, constants.EVENT_PERMISSION_MANAGE) {
+		return EventTemplateResponseDto{}, constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+
+	template := mapEventToTemplate(source, strings.TrimSpace(data.Name))
+	template.OwnerId = user.Id
+	template.CreatedAt = time.Now().UTC()
+	if err := s.templateRepository.Create(&template); err != nil {
+		return EventTemplateResponseDto{}, err
+	}
+
+	return MapToEventTemplateResponseDto(template), nil
+}
+
+func (s *EventTemplateService) List(user *guard.Claims) ([]EventTemplateResponseDto, error) {
+	var templates []model.EventTemplate
+	if err := s.templateRepository.FindByOwnerId(user.Id, &templates); err != nil {
+		return nil, err
+	}
+
+	result := make([]EventTemplateResponseDto, 0, len(templates))
+	for _, template := range templates {
+		result = append(result, MapToEventTemplateResponseDto(template))
+	}
+
+	return result, nil
+}
+
+// Delete removes a template, templates of other accounts are treated as unknown
+func (s *EventTemplateService) Delete(templateId uuid.UUID, user *guard.Claims) error {
+	if err := s.templateRepository.Delete(templateId, user.Id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_TEMPLATE_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	return nil
+}
+
+// CreateEvent creates a new event owned by the user from one of the user's templates
+func (s *EventTemplateService) CreateEvent(templateId uuid.UUID, data *EventTemplateUseDto, user *guard.Claims) (event.EventCreateResponseDto, error) {
+	var template model.EventTemplate
+	if err := s.templateRepository.FindOneByIdAndOwnerId(templateId, user.Id, &template); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return event.EventCreateResponseDto{}, constants.ERR_EVENT_TEMPLATE_NOT_FOUND.Err
+		}
+		return event.EventCreateResponseDto{}, err
+	}
+
+	createDto := mapTemplateToEventCreateDto(template, data.StartsAt)
+	if data.Name != nil {
+		createDto.Name = *data.Name
+	}
+
+	return s.eventService.Create(&createDto, user)
+}
@@ -0,0 +1,81 @@
+package eventtemplate
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	model "app/db/models"
+	"app/db/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestMapEventToTemplate(t *testing.T) {
+	description := "Demo of the sprint"
+	meetingUrl := "https://meet.example.com/abc"
+	source := model.Event{
+		OwnerId:     uuid.New(),
+		Name:        "Sprint review",
+		Description: &description,
+		Duration:    60,
+		StartsAt:    time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC),
+		EndsAt:      time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC),
+		InviteOnly:  true,
+		MeetingUrl:  &meetingUrl,
+		AutoConfirm: true,
+	}
+
+	template := mapEventToTemplate(source, "Sprint review template")
+	assert.Equal(t, "Sprint review template", template.Name)
+	assert.Equal(t, "Sprint review", template.EventName)
+	assert.Equal(t, 5*24*60, template.Period)
+	assert.Equal(t, &meetingUrl, template.MeetingUrl)
+
+	startsAt := time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)
+	dto := mapTemplateToEventCreateDto(template, startsAt)
+	assert.Equal(t, "Sprint review", dto.Name)
+	assert.Equal(t, 0, dto.Days)
+	assert.Equal(t, 1, dto.Hours)
+	assert.Equal(t, 0, dto.Minutes)
+	assert.Equal(t, startsAt, dto.StartsAt)
+	assert.Equal(t, time.Date(2025, 3, 22, 0, 0, 0, 0, time.UTC), dto.EndsAt)
+	assert.True(t, *dto.InviteOnly)
+	assert.True(t, dto.AutoConfirm)
+	assert.Equal(t, description, *dto.Description)
+	assert.NotSame(t, template.Description, dto.Description, "Create trims the description in place")
+
+	source.AutoMeetingLink = true
+	template = mapEventToTemplate(source, "Generated link")
+	assert.Nil(t, template.MeetingUrl, "generated meeting links are not reused")
+}
+
+func TestTemplatesOfOtherAccounts(t *testing.T) {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, database.AutoMigrate(&model.Account{}, &model.EventTemplate{}))
+
+	service := &EventTemplateService{templateRepository: repository.NewEventTemplateRepository(database)}
+	owner := &guard.Claims{Id: uuid.New()}
+	other := &guard.Claims{Id: uuid.New()}
+
+	template := model.EventTemplate{OwnerId: owner.Id, Name: "Weekly", EventName: "Weekly sync", Duration: 30, Period: 7 * 24 * 60}
+	require.NoError(t, service.templateRepository.Create(&template))
+
+	list, err := service.List(other)
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+
+	_, err = service.CreateEvent(template.Id, &EventTemplateUseDto{StartsAt: time.Now().Add(24 * time.Hour)}, other)
+	assert.Equal(t, constants.ERR_EVENT_TEMPLATE_NOT_FOUND.Err, err)
+	assert.Equal(t, constants.ERR_EVENT_TEMPLATE_NOT_FOUND.Err, service.Delete(template.Id, other))
+
+	list, err = service.List(owner)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.NoError(t, service.Delete(template.Id, owner))
+}
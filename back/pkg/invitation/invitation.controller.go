@@ -0,0 +1,125 @@
+package invitation
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	"app/commons/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type InvitationController struct {
+	invitationService *InvitationService
+}
+
+func NewInvitationController(ctl *InvitationController) *InvitationController {
+	if ctl != nil {
+		return ctl
+	}
+
+	return &InvitationController{
+		invitationService: NewInvitationService(nil),
+	}
+}
+
+func (ctl *InvitationController) getEventIdParam(c *gin.Context) (uuid.UUID, error) {
+	eventId, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return uuid.Nil, err
+	}
+
+	return eventId, nil
+}
+
+// @Summary Create an invitation link
+// @Description Owner and organisers only. Creates an invitation link with an optional expiry date and maximum number of uses.
+// @Tags Invitation
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param data body InvitationCreateDto true "Invitation parameters"
+// @Security BearerAuth
+// @Success 200 {object} InvitationResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, or ERR_INVITATION_INVALID_EXPIRATION"
+// @Router /api/v1/events/{eventId}/invitations [post]
+func (ctl *InvitationController) Create(c *gin.Context) {
+	var data InvitationCreateDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.invitationService.Create(&data, eventId, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary List invitation links
+// @Description Owner and organisers only. Lists the invitation links of the event with their usage, newest first.
+// @Tags Invitation
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Security BearerAuth
+// @Success 200 {array} InvitationResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_EVENT_ACCESS_DENIED"
+// @Router /api/v1/events/{eventId}/invitations [get]
+func (ctl *InvitationController) List(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.invitationService.List(eventId, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary Revoke an invitation link
+// @Description Owner and organisers only. The link can no longer be used to join the event.
+// @Tags Invitation
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param invitationId path string true "Invitation Id"
+// @Security BearerAuth
+// @Success 200
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, or ERR_INVITATION_NOT_FOUND"
+// @Router /api/v1/events/{eventId}/invitations/{invitationId} [delete]
+func (ctl *InvitationController) Revoke(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	invitationId, err := uuid.Parse(c.Param("invitationId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_INVITATION_NOT_FOUND.Err)
+		return
+	}
+
+	err = ctl.invitationService.Revoke(eventId, invitationId, user)
+	helpers.HandleJSONResponse(c, nil, err)
+}
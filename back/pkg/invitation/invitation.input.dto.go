@@ -0,0 +1,9 @@
+package invitation
+
+import "time"
+
+// InvitationCreateDto - POST /events/:id/invitations
+type InvitationCreateDto struct {
+	ExpiresAt *time.Time `json:"expiresAt"`
+	MaxUses   *int       `json:"maxUses" binding:"omitempty,min=1"`
+}
@@ -0,0 +1,27 @@
+package invitation
+
+import (
+	model "app/db/models"
+	"fmt"
+	"time"
+)
+
+// invitationUrl builds the link shared with invitees
+func invitationUrl(origin string, i model.EventInvitation) string {
+	return fmt.Sprintf("%s/event/%s?invitation=%s", origin, i.EventId.String(), i.Token)
+}
+
+func MapToInvitationResponseDto(i model.EventInvitation, origin string, now time.Time) InvitationResponseDto {
+	return InvitationResponseDto{
+		Id:         i.Id,
+		Token:      i.Token,
+		Url:        invitationUrl(origin, i),
+		ExpiresAt:  i.ExpiresAt,
+		MaxUses:    i.MaxUses,
+		UseCount:   i.UseCount,
+		LastUsedAt: i.LastUsedAt,
+		RevokedAt:  i.RevokedAt,
+		IsActive:   i.IsActive(now),
+		CreatedAt:  i.CreatedAt,
+	}
+}
@@ -0,0 +1,21 @@
+package invitation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InvitationResponseDto - POST and GET /events/:id/invitations
+type InvitationResponseDto struct {
+	Id         uuid.UUID  `json:"id"`
+	Token      string     `json:"token"`
+	Url        string     `json:"url"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	MaxUses    *int       `json:"maxUses"`
+	UseCount   int        `json:"useCount"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	IsActive   bool       `json:"isActive"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
@@ -0,0 +1,168 @@
+package invitation
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	"app/config"
+	model "app/db/models"
+	"app/db/repository"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type InvitationService struct {
+	invitationRepository *repository.EventInvitationRepository
+	eventRepository      *repository.EventRepository
+	config               *config.Config
+}
+
+func NewInvitationService(service *InvitationService) *InvitationService {
+	if service != nil {
+		return service
+	}
+
+	return &InvitationService{
+		invitationRepository: repository.NewEventInvitationRepository(nil),
+		eventRepository:      repository.NewEventRepository(nil),
+		config:               config.GetConfig(),
+	}
+}
+
+// getManagedEvent loads the event and checks that the user can manage it
+func (s *InvitationService) getManagedEvent(eventId uuid.UUID, userId uuid.UUID, event *model.Event) error {
+	if err := s.eventRepository.FindOneById(eventId, event); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	if !event.Can(&userId, constants.EVENT_PERMISSION_MANAGE) {
+		return constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+
+	return nil
+}
+
+// checkUsable returns the error matching the reason why an invitation cannot be used
+func checkUsable(invitation *model.EventInvitation, now time.Time) error {
+	if invitation.RevokedAt != nil {
+		return constants.ERR_INVITATION_REVOKED.Err
+	}
+	if invitation.IsExpired(now) {
+		return constants.ERR_INVITATION_EXPIRED.Err
+	}
+	if invitation.IsExhausted() {
+		return constants.ERR_INVITATION_MAX_USES_REACHED.Err
+	}
+
+	return nil
+}
+
+func (s *InvitationService) Create(data *InvitationCreateDto, eventId uuid.UUID, user *guard.Claims) (InvitationResponseDto, error) {
+	var event model.Event
+	if err := s.getManagedEvent(eventId, user.Id, &event); err != nil {
+		return InvitationResponseDto{}, err
+	}
+
+	now := time.Now().UTC()
+	if data.ExpiresAt != nil && !data.ExpiresAt.After(now) {
+		return InvitationResponseDto{}, constants.ERR_INVITATION_INVALID_EXPIRATION.Err
+	}
+
+	invitation := model.EventInvitation{
+		EventId:     event.Id,
+		CreatedById: user.Id,
+		ExpiresAt:   data.ExpiresAt,
+		MaxUses:     data.MaxUses,
+		CreatedAt:   now,
+	}
+	if err := s.invitationRepository.Create(&invitation); err != nil {
+		return InvitationResponseDto{}, err
+	}
+
+	return MapToInvitationResponseDto(invitation, s.config.Origin, now), nil
+}
+
+func (s *InvitationService) List(eventId uuid.UUID, user *guard.Claims) ([]InvitationResponseDto, error) {
+	var event model.Event
+	if err := s.getManagedEvent(eventId, user.Id, &event); err != nil {
+		return nil, err
+	}
+
+	var invitations []model.EventInvitation
+	if err := s.invitationRepository.FindByEventId(event.Id, &invitations); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	result := make([]InvitationResponseDto, 0, len(invitations))
+	for _, invitation := range invitations {
+		result = append(result, MapToInvitationResponseDto(invitation, s.config.Origin, now))
+	}
+
+	return result, nil
+}
+
+func (s *InvitationService) Revoke(eventId uuid.UUID, invitationId uuid.UUID, user *guard.Claims) error {
+	var event model.Event
+	if err := s.getManagedEvent(eventId, user.Id, &event); err != nil {
+		return err
+	}
+
+	var invitation model.EventInvitation
+	if err := s.invitationRepository.FindOneByIdAndEventId(invitationId, event.Id, &invitation); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_INVITATION_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	return s.invitationRepository.Revoke(&invitation)
+}
+
+// Resolve finds the invitation used to join the event.
+// Returns nil without error when no token is given and the event is public.
+func (s *InvitationService) Resolve(event *model.Event, token *string) (*model.EventInvitation, error) {
+	if token == nil || *token == "" {
+		if event.InviteOnly {
+			return nil, constants.ERR_INVITATION_REQUIRED.Err
+		}
+		return nil, nil
+	}
+
+	var invitation model.EventInvitation
+	if err := s.invitationRepository.FindOneByToken(*token, &invitation); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constants.ERR_INVITATION_NOT_FOUND.Err
+		}
+		return nil, err
+	}
+
+	// A token of another event is treated as unknown
+	if invitation.EventId != event.Id {
+		return nil, constants.ERR_INVITATION_NOT_FOUND.Err
+	}
+
+	if err := checkUsable(&invitation, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	return &invitation, nil
+}
+
+// Join creates the membership and records the use of the invitation
+func (s *InvitationService) Join(invitation *model.EventInvitation, accountEvent *model.AccountEvent) error {
+	if err := s.invitationRepository.JoinWithInvitation(invitation.Id, accountEvent); err != nil {
+		// The last use was taken, or the invitation revoked, since it was resolved
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_INVITATION_MAX_USES_REACHED.Err
+		}
+		return err
+	}
+
+	return nil
+}
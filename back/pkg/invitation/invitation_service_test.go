@@ -0,0 +1,70 @@
+package invitation
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckUsable(t *testing.T) {
+	now := time.Now().UTC()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	two := 2
+
+	tests := []struct {
+		name       string
+		invitation model.EventInvitation
+		want       error
+	}{
+		{"unlimited", model.EventInvitation{}, nil},
+		{"not yet expired", model.EventInvitation{ExpiresAt: &future}, nil},
+		{"uses left", model.EventInvitation{MaxUses: &two, UseCount: 1}, nil},
+		{"expired", model.EventInvitation{ExpiresAt: &past}, constants.ERR_INVITATION_EXPIRED.Err},
+		{"expires now", model.EventInvitation{ExpiresAt: &now}, constants.ERR_INVITATION_EXPIRED.Err},
+		{"max uses reached", model.EventInvitation{MaxUses: &two, UseCount: 2}, constants.ERR_INVITATION_MAX_USES_REACHED.Err},
+		{"revoked wins over expiry", model.EventInvitation{RevokedAt: &past, ExpiresAt: &past}, constants.ERR_INVITATION_REVOKED.Err},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, checkUsable(&tt.invitation, now))
+			assert.Equal(t, tt.want == nil, tt.invitation.IsActive(now))
+		})
+	}
+}
+
+func TestResolve_WithoutToken(t *testing.T) {
+	service := &InvitationService{}
+	empty := ""
+
+	t.Run("public event needs no invitation", func(t *testing.T) {
+		event := model.Event{Id: uuid.New()}
+		invitation, err := service.Resolve(&event, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, invitation)
+	})
+
+	t.Run("invite-only event requires an invitation", func(t *testing.T) {
+		event := model.Event{Id: uuid.New(), InviteOnly: true}
+		_, err := service.Resolve(&event, &empty)
+		assert.Equal(t, constants.ERR_INVITATION_REQUIRED.Err, err)
+	})
+}
+
+func TestMapToInvitationResponseDto(t *testing.T) {
+	now := time.Now().UTC()
+	invitation := model.EventInvitation{
+		Id:      uuid.New(),
+		EventId: uuid.New(),
+		Token:   "abc",
+	}
+
+	dto := MapToInvitationResponseDto(invitation, "https://app.example.com", now)
+
+	assert.Equal(t, "https://app.example.com/event/"+invitation.EventId.String()+"?invitation=abc", dto.Url)
+	assert.True(t, dto.IsActive)
+}
@@ -0,0 +1,160 @@
+package joinrequest
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	"app/commons/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type JoinRequestController struct {
+	joinRequestService *JoinRequestService
+}
+
+func NewJoinRequestController(ctl *JoinRequestController) *JoinRequestController {
+	if ctl != nil {
+		return ctl
+	}
+
+	return &JoinRequestController{
+		joinRequestService: NewJoinRequestService(nil),
+	}
+}
+
+func (ctl *JoinRequestController) getEventIdParam(c *gin.Context) (uuid.UUID, error) {
+	eventId, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return uuid.Nil, err
+	}
+
+	return eventId, nil
+}
+
+func (ctl *JoinRequestController) getRequestIdParam(c *gin.Context) (uuid.UUID, error) {
+	requestId, err := uuid.Parse(c.Param("requestId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_JOIN_REQUEST_NOT_FOUND.Err)
+		return uuid.Nil, err
+	}
+
+	return requestId, nil
+}
+
+// @Summary List pending join requests
+// @Description Owner and organisers only. Lists the join requests waiting for approval, oldest first.
+// @Tags JoinRequest
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Security BearerAuth
+// @Success 200 {array} JoinRequestResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_EVENT_ACCESS_DENIED"
+// @Router /api/v1/events/{eventId}/join-requests [get]
+func (ctl *JoinRequestController) List(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.joinRequestService.List(eventId, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary List the waitlist
+// @Description Owner and organisers only. Lists the users waiting for a seat, in the order they will become participants.
+// @Tags JoinRequest
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Security BearerAuth
+// @Success 200 {array} JoinRequestResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_EVENT_ACCESS_DENIED"
+// @Router /api/v1/events/{eventId}/waitlist [get]
+func (ctl *JoinRequestController) ListWaitlist(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.joinRequestService.ListWaitlist(eventId, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary Approve a join request
+// @Description Owner and organisers only. The requester becomes a participant of the event, or is waitlisted with the WAITLISTED status when the event is full.
+// @Tags JoinRequest
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param requestId path string true "Join request Id"
+// @Security BearerAuth
+// @Success 200 {object} JoinRequestResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_JOIN_REQUEST_NOT_FOUND, or ERR_JOIN_REQUEST_ALREADY_DECIDED"
+// @Router /api/v1/events/{eventId}/join-requests/{requestId}/approve [post]
+func (ctl *JoinRequestController) Approve(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	requestId, err := ctl.getRequestIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.joinRequestService.Approve(eventId, requestId, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary Reject a join request
+// @Description Owner and organisers only. The requester cannot ask to join the event again.
+// @Tags JoinRequest
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param requestId path string true "Join request Id"
+// @Security BearerAuth
+// @Success 200 {object} JoinRequestResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_JOIN_REQUEST_NOT_FOUND, or ERR_JOIN_REQUEST_ALREADY_DECIDED"
+// @Router /api/v1/events/{eventId}/join-requests/{requestId}/reject [post]
+func (ctl *JoinRequestController) Reject(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	requestId, err := ctl.getRequestIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.joinRequestService.Reject(eventId, requestId, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
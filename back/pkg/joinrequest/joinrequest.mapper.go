@@ -0,0 +1,18 @@
+package joinrequest
+
+import model "app/db/models"
+
+func MapToJoinRequestResponseDto(jr model.JoinRequest) JoinRequestResponseDto {
+	return JoinRequestResponseDto{
+		Id:     jr.Id,
+		Status: jr.Status,
+		Account: JoinRequestAccountDto{
+			Id:        jr.AccountId,
+			UserName:  jr.Account.UserName,
+			AvatarUrl: jr.Account.AvatarUrl,
+			IsGuest:   jr.Account.IsGuest,
+		},
+		CreatedAt: jr.CreatedAt,
+		DecidedAt: jr.DecidedAt,
+	}
+}
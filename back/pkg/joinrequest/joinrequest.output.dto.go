@@ -0,0 +1,25 @@
+package joinrequest
+
+import (
+	"app/commons/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JoinRequestAccountDto - account asking to join
+type JoinRequestAccountDto struct {
+	Id        uuid.UUID `json:"id"`
+	UserName  *string   `json:"userName"`
+	AvatarUrl string    `json:"avatarUrl"`
+	IsGuest   bool      `json:"isGuest"`
+}
+
+// JoinRequestResponseDto - POST /events/:id/join (pending) and GET /events/:id/join-requests
+type JoinRequestResponseDto struct {
+	Id        uuid.UUID                   `json:"id"`
+	Status    constants.JoinRequestStatus `json:"status"`
+	Account   JoinRequestAccountDto       `json:"account"`
+	CreatedAt time.Time                   `json:"createdAt"`
+	DecidedAt *time.Time                  `json:"decidedAt"`
+}
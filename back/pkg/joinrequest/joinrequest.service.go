@@ -0,0 +1,322 @@
+package joinrequest
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	model "app/db/models"
+	"app/db/repository"
+	"app/pkg/domainevent"
+	"app/pkg/lifecycle"
+	"app/pkg/mail"
+	"app/pkg/sse"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type JoinRequestService struct {
+	joinRequestRepository *repository.JoinRequestRepository
+	eventRepository       *repository.EventRepository
+	accountRepository     *repository.AccountRepository
+	mailService           *mail.MailService
+	sseService            *sse.SSEService
+	lifecycleService      *lifecycle.LifecycleService
+	domainEventBus        *domainevent.Bus
+}
+
+func NewJoinRequestService(service *JoinRequestService) *JoinRequestService {
+	if service != nil {
+		return service
+	}
+
+	return &JoinRequestService{
+		joinRequestRepository: repository.NewJoinRequestRepository(nil),
+		eventRepository:       repository.NewEventRepository(nil),
+		accountRepository:     repository.NewAccountRepository(nil),
+		mailService:           mail.NewMailService(nil),
+		sseService:            sse.GetSSEService(),
+		lifecycleService:      lifecycle.NewLifecycleService(nil),
+		domainEventBus:        domainevent.GetBus(),
+	}
+}
+
+// checkExistingRequest returns the error matching a previous request that blocks a new one
+func checkExistingRequest(joinRequest *model.JoinRequest) error {
+	switch joinRequest.Status {
+	case constants.JOIN_REQUEST_STATUS_PENDING:
+		return constants.ERR_JOIN_REQUEST_PENDING.Err
+	case constants.JOIN_REQUEST_STATUS_REJECTED:
+		return constants.ERR_JOIN_REQUEST_REJECTED.Err
+	case constants.JOIN_REQUEST_STATUS_WAITLISTED:
+		return constants.ERR_JOIN_REQUEST_WAITLISTED.Err
+	}
+
+	return nil
+}
+
+// findLatest returns the latest request of the user for the event, nil when there is none
+func (s *JoinRequestService) findLatest(eventId uuid.UUID, userId uuid.UUID) (*model.JoinRequest, error) {
+	var joinRequest model.JoinRequest
+	if err := s.joinRequestRepository.FindLatestByAccountAndEventId(userId, eventId, &joinRequest); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &joinRequest, nil
+}
+
+// CheckPending returns ERR_JOIN_REQUEST_PENDING when the user waits for the owner's approval
+func (s *JoinRequestService) CheckPending(eventId uuid.UUID, userId uuid.UUID) error {
+	joinRequest, err := s.findLatest(eventId, userId)
+	if err != nil {
+		return err
+	}
+	if joinRequest != nil && joinRequest.IsPending() {
+		return constants.ERR_JOIN_REQUEST_PENDING.Err
+	}
+
+	return nil
+}
+
+// CheckWaitlisted returns ERR_JOIN_REQUEST_WAITLISTED when the user waits for a seat
+func (s *JoinRequestService) CheckWaitlisted(eventId uuid.UUID, userId uuid.UUID) error {
+	joinRequest, err := s.findLatest(eventId, userId)
+	if err != nil {
+		return err
+	}
+	if joinRequest != nil && joinRequest.Status == constants.JOIN_REQUEST_STATUS_WAITLISTED {
+		return constants.ERR_JOIN_REQUEST_WAITLISTED.Err
+	}
+
+	return nil
+}
+
+// CheckCanRequest rejects users who already have a pending or rejected request
+func (s *JoinRequestService) CheckCanRequest(eventId uuid.UUID, userId uuid.UUID) error {
+	joinRequest, err := s.findLatest(eventId, userId)
+	if err != nil {
+		return err
+	}
+	if joinRequest == nil {
+		return nil
+	}
+
+	return checkExistingRequest(joinRequest)
+}
+
+// create saves a request of the user with the given status
+func (s *JoinRequestService) create(event *model.Event, user *guard.Claims, invitationId *uuid.UUID, status constants.JoinRequestStatus) (JoinRequestResponseDto, error) {
+	joinRequest := model.JoinRequest{
+		Id:           uuid.New(),
+		EventId:      event.Id,
+		AccountId:    user.Id,
+		InvitationId: invitationId,
+		Status:       status,
+		CreatedAt:    time.Now().UTC(),
+		Account: model.Account{
+			Id:       user.Id,
+			UserName: user.Username,
+			IsGuest:  user.Guest,
+		},
+	}
+	if err := s.joinRequestRepository.Create(&joinRequest); err != nil {
+		// The last use of the invitation was taken, or the invitation revoked, since it was resolved
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return JoinRequestResponseDto{}, constants.ERR_INVITATION_MAX_USES_REACHED.Err
+		}
+		return JoinRequestResponseDto{}, err
+	}
+
+	return MapToJoinRequestResponseDto(joinRequest), nil
+}
+
+// Waitlist puts the user on the waitlist of a full event, the user becomes a member when a seat opens up
+func (s *JoinRequestService) Waitlist(event *model.Event, user *guard.Claims, invitationId *uuid.UUID) (JoinRequestResponseDto, error) {
+	return s.create(event, user, invitationId, constants.JOIN_REQUEST_STATUS_WAITLISTED)
+}
+
+// Request creates a pending request and notifies the owner by email and SSE
+func (s *JoinRequestService) Request(event *model.Event, user *guard.Claims, invitationId *uuid.UUID) (JoinRequestResponseDto, error) {
+	result, err := s.create(event, user, invitationId, constants.JOIN_REQUEST_STATUS_PENDING)
+	if err != nil {
+		return JoinRequestResponseDto{}, err
+	}
+
+	requesterName := ""
+	if user.Username != nil {
+		requesterName = *user.Username
+	}
+
+	var owner model.Account
+	if err := s.accountRepository.FindOneById(event.OwnerId, &owner); err != nil {
+		log.Error().Err(err).Str("eventId", event.Id.String()).Msg("JOIN_REQUEST_SERVICE::REQUEST Failed to get owner for join request mail")
+	} else {
+		go s.mailService.SendJoinRequestEmail(owner, *event, requesterName)
+	}
+
+	s.sseService.SendToUser(event.Id, event.OwnerId, constants.SSE_EVENT_JOIN_REQUEST, result)
+
+	return result, nil
+}
+
+// getManagedEvent loads the event and checks that the user can manage it
+func (s *JoinRequestService) getManagedEvent(eventId uuid.UUID, userId uuid.UUID, event *model.Event) error {
+	if err := s.eventRepository.FindOneById(eventId, event); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	if !event.Can(&userId, constants.EVENT_PERMISSION_MANAGE) {
+		return constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+
+	return nil
+}
+
+// getPendingRequest loads a request of the event that has not been decided yet
+func (s *JoinRequestService) getPendingRequest(eventId uuid.UUID, requestId uuid.UUID, joinRequest *model.JoinRequest) error {
+	if err := s.joinRequestRepository.FindOneByIdAndEventId(requestId, eventId, joinRequest); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_JOIN_REQUEST_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	if !joinRequest.IsPending() {
+		return constants.ERR_JOIN_REQUEST_ALREADY_DECIDED.Err
+	}
+
+	return nil
+}
+
+func (s *JoinRequestService) List(eventId uuid.UUID, user *guard.Claims) ([]JoinRequestResponseDto, error) {
+	var event model.Event
+	if err := s.getManagedEvent(eventId, user.Id, &event); err != nil {
+		return nil, err
+	}
+
+	var joinRequests []model.JoinRequest
+	if err := s.joinRequestRepository.FindPendingByEventId(event.Id, &joinRequests); err != nil {
+		return nil, err
+	}
+
+	result := make([]JoinRequestResponseDto, 0, len(joinRequests))
+	for _, joinRequest := range joinRequests {
+		result = append(result, MapToJoinRequestResponseDto(joinRequest))
+	}
+
+	return result, nil
+}
+
+// ListWaitlist lists the waitlisted users of the event in the order they will become members
+func (s *JoinRequestService) ListWaitlist(eventId uuid.UUID, user *guard.Claims) ([]JoinRequestResponseDto, error) {
+	var event model.Event
+	if err := s.getManagedEvent(eventId, user.Id, &event); err != nil {
+		return nil, err
+	}
+
+	var joinRequests []model.JoinRequest
+	if err := s.joinRequestRepository.FindWaitlistedByEventId(event.Id, &joinRequests); err != nil {
+		return nil, err
+	}
+
+	result := make([]JoinRequestResponseDto, 0, len(joinRequests))
+	for _, joinRequest := range joinRequests {
+		result = append(result, MapToJoinRequestResponseDto(joinRequest))
+	}
+
+	return result, nil
+}
+
+// PromoteWaitlisted makes the waitlisted users members while the event has free seats and tells them by email.
+// Returns the number of promoted users.
+func (s *JoinRequestService) PromoteWaitlisted(event *model.Event) int {
+	promoted := 0
+	for {
+		var joinRequest model.JoinRequest
+		if err := s.joinRequestRepository.PromoteFirstWaitlisted(event.Id, &joinRequest); err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) && !errors.Is(err, constants.ERR_EVENT_FULL.Err) {
+				log.Error().Err(err).Str("eventId", event.Id.String()).Msg("JOIN_REQUEST_SERVICE::PROMOTE_WAITLISTED Failed to promote waitlisted user")
+			}
+			return promoted
+		}
+		promoted++
+		s.domainEventBus.Publish(domainevent.NewMemberJoined(event.Id, joinRequest.AccountId, constants.EVENT_ROLE_PARTICIPANT, nil))
+
+		go s.mailService.SendWaitlistPromotedEmail(joinRequest.Account, *event)
+	}
+}
+
+// LeaveWaitlist removes the user from the waitlist of the event.
+// Returns ERR_EVENT_NOT_FOUND when the user is not waitlisted.
+func (s *JoinRequestService) LeaveWaitlist(eventId uuid.UUID, userId uuid.UUID) error {
+	if err := s.joinRequestRepository.DeleteWaitlisted(userId, eventId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *JoinRequestService) Approve(eventId uuid.UUID, requestId uuid.UUID, user *guard.Claims) (JoinRequestResponseDto, error) {
+	var event model.Event
+	if err := s.getManagedEvent(eventId, user.Id, &event); err != nil {
+		return JoinRequestResponseDto{}, err
+	}
+
+	var joinRequest model.JoinRequest
+	if err := s.getPendingRequest(event.Id, requestId, &joinRequest); err != nil {
+		return JoinRequestResponseDto{}, err
+	}
+
+	// Members can only be added while the event is open
+	if hasStatus, err := event.CheckAndAutoUpdateStatus(s.lifecycleService.Finish, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION, constants.EVENT_STATUS_UPCOMING}); !hasStatus || err != nil {
+		if err != nil {
+			return JoinRequestResponseDto{}, err
+		}
+		return JoinRequestResponseDto{}, event.LockedError()
+	}
+
+	if err := s.joinRequestRepository.Approve(&joinRequest); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return JoinRequestResponseDto{}, constants.ERR_JOIN_REQUEST_ALREADY_DECIDED.Err
+		}
+		return JoinRequestResponseDto{}, err
+	}
+	// A full event waitlists the request instead
+	if joinRequest.Status == constants.JOIN_REQUEST_STATUS_APPROVED {
+		s.domainEventBus.Publish(domainevent.NewMemberJoined(event.Id, joinRequest.AccountId, constants.EVENT_ROLE_PARTICIPANT, &user.Id))
+	}
+
+	return MapToJoinRequestResponseDto(joinRequest), nil
+}
+
+func (s *JoinRequestService) Reject(eventId uuid.UUID, requestId uuid.UUID, user *guard.Claims) (JoinRequestResponseDto, error) {
+	var event model.Event
+	if err := s.getManagedEvent(eventId, user.Id, &event); err != nil {
+		return JoinRequestResponseDto{}, err
+	}
+
+	var joinRequest model.JoinRequest
+	if err := s.getPendingRequest(event.Id, requestId, &joinRequest); err != nil {
+		return JoinRequestResponseDto{}, err
+	}
+
+	if err := s.joinRequestRepository.Reject(&joinRequest); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return JoinRequestResponseDto{}, constants.ERR_JOIN_REQUEST_ALREADY_DECIDED.Err
+		}
+		return JoinRequestResponseDto{}, err
+	}
+
+	return MapToJoinRequestResponseDto(joinRequest), nil
+}
@@ -0,0 +1,54 @@
+package joinrequest
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckExistingRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		status constants.JoinRequestStatus
+		want   error
+	}{
+		{"pending blocks a new request", constants.JOIN_REQUEST_STATUS_PENDING, constants.ERR_JOIN_REQUEST_PENDING.Err},
+		{"rejected blocks a new request", constants.JOIN_REQUEST_STATUS_REJECTED, constants.ERR_JOIN_REQUEST_REJECTED.Err},
+		{"waitlisted blocks a new request", constants.JOIN_REQUEST_STATUS_WAITLISTED, constants.ERR_JOIN_REQUEST_WAITLISTED.Err},
+		{"approved allows a new request", constants.JOIN_REQUEST_STATUS_APPROVED, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, checkExistingRequest(&model.JoinRequest{Status: tt.status}))
+		})
+	}
+}
+
+func TestMapToJoinRequestResponseDto(t *testing.T) {
+	username := "guest"
+	decidedAt := time.Now().UTC()
+	joinRequest := model.JoinRequest{
+		Id:        uuid.New(),
+		AccountId: uuid.New(),
+		Status:    constants.JOIN_REQUEST_STATUS_APPROVED,
+		CreatedAt: decidedAt.Add(-time.Hour),
+		DecidedAt: &decidedAt,
+		Account: model.Account{
+			UserName: &username,
+			IsGuest:  true,
+		},
+	}
+
+	result := MapToJoinRequestResponseDto(joinRequest)
+
+	assert.Equal(t, joinRequest.Id, result.Id)
+	assert.Equal(t, joinRequest.AccountId, result.Account.Id)
+	assert.Equal(t, &username, result.Account.UserName)
+	assert.True(t, result.Account.IsGuest)
+	assert.Equal(t, constants.JOIN_REQUEST_STATUS_APPROVED, result.Status)
+	assert.Equal(t, &decidedAt, result.DecidedAt)
+}
@@ -0,0 +1,94 @@
+package lifecycle
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
+	"app/pkg/domainevent"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+const checkInterval = time.Minute // Interval between two checks of the events to transition
+
+// Statuses of the events that can still finish
+var openStatuses = []constants.EventStatus{constants.EVENT_STATUS_IN_DECISION, constants.EVENT_STATUS_UPCOMING}
+
+// LifecycleService moves the events through their lifecycle in the background, so that they finish on time
+// even when nobody opens them, and publishes a domain event for each transition.
+type LifecycleService struct {
+	eventRepository *repository.EventRepository
+	domainEventBus  *domainevent.Bus
+}
+
+func NewLifecycleService(service *LifecycleService) *LifecycleService {
+	if service != nil {
+		return service
+	}
+
+	return &LifecycleService{
+		eventRepository: repository.NewEventRepository(nil),
+		domainEventBus:  domainevent.GetBus(),
+	}
+}
+
+// Start checks the events to transition periodically until the context is done
+func (s *LifecycleService) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.Check(time.Now())
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Check finishes the open events whose period or validated slot has ended
+func (s *LifecycleService) Check(now time.Time) {
+	events, err := s.eventRepository.FindEventsToFinish(now)
+	if err != nil {
+		return
+	}
+
+	for _, event := range events {
+		if err := s.finish(event.Id); err != nil {
+			log.Error().Err(err).Str("eventId", event.Id.String()).Msg("LIFECYCLE_SERVICE::CHECK Failed to finish event")
+		}
+	}
+}
+
+// Finish is the update function given to model.Event.CheckAndAutoUpdateStatus: the event read after its end
+// finishes the same way as with the scheduler, then it is reloaded with its current status.
+func (s *LifecycleService) Finish(event *model.Event) error {
+	if err := s.finish(event.Id); err != nil {
+		return err
+	}
+
+	return s.eventRepository.FindOneById(event.Id, event)
+}
+
+// finish moves the event to FINISHED and publishes the transition.
+// Nothing happens when the event is not open anymore, e.g. when another instance finished it first.
+func (s *LifecycleService) finish(eventId uuid.UUID) error {
+	from, err := s.eventRepository.TransitionStatus(eventId, openStatuses, constants.EVENT_STATUS_FINISHED)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	s.domainEventBus.Publish(domainevent.NewStatusChanged(eventId, from, constants.EVENT_STATUS_FINISHED, nil))
+	return nil
+}
@@ -0,0 +1,58 @@
+package lifecycle
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
+	"app/pkg/domainevent"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestCheckFinishesEndedEvents(t *testing.T) {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, database.AutoMigrate(&model.Event{}, &model.Slot{}))
+
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	createEvent := func(status constants.EventStatus, endsAt time.Time) model.Event {
+		event := model.Event{Id: uuid.New(), Name: "Team meeting", OwnerId: uuid.New(), Status: status, StartsAt: now.Add(-72 * time.Hour), EndsAt: endsAt}
+		require.NoError(t, database.Omit("Owner").Create(&event).Error)
+		return event
+	}
+	periodEnded := createEvent(constants.EVENT_STATUS_IN_DECISION, now.Add(-time.Hour))
+	slotEnded := createEvent(constants.EVENT_STATUS_UPCOMING, now.Add(72*time.Hour))
+	require.NoError(t, database.Omit("Event").Create(&model.Slot{Id: uuid.New(), EventId: slotEnded.Id, StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour), IsValidated: true}).Error)
+	cancelled := createEvent(constants.EVENT_STATUS_CANCELLED, now.Add(-time.Hour))
+	ongoing := createEvent(constants.EVENT_STATUS_IN_DECISION, now.Add(72*time.Hour))
+
+	bus := domainevent.NewBus()
+	published := map[uuid.UUID][]domainevent.StatusChanged{}
+	bus.Subscribe(constants.DOMAIN_EVENT_STATUS_CHANGED, func(event domainevent.DomainEvent) {
+		published[event.EventId] = append(published[event.EventId], event.Payload.(domainevent.StatusChanged))
+	})
+	service := &LifecycleService{eventRepository: repository.NewEventRepository(database), domainEventBus: bus}
+
+	service.Check(now)
+	service.Check(now)
+
+	statusOf := func(eventId uuid.UUID) constants.EventStatus {
+		var event model.Event
+		require.NoError(t, database.First(&event, "id = ?", eventId).Error)
+		return event.Status
+	}
+	assert.Equal(t, constants.EVENT_STATUS_FINISHED, statusOf(periodEnded.Id))
+	assert.Equal(t, constants.EVENT_STATUS_FINISHED, statusOf(slotEnded.Id))
+	assert.Equal(t, constants.EVENT_STATUS_CANCELLED, statusOf(cancelled.Id))
+	assert.Equal(t, constants.EVENT_STATUS_IN_DECISION, statusOf(ongoing.Id))
+
+	assert.Equal(t, []domainevent.StatusChanged{{From: constants.EVENT_STATUS_IN_DECISION, To: constants.EVENT_STATUS_FINISHED}}, published[periodEnded.Id])
+	assert.Equal(t, []domainevent.StatusChanged{{From: constants.EVENT_STATUS_UPCOMING, To: constants.EVENT_STATUS_FINISHED}}, published[slotEnded.Id])
+	assert.Len(t, published, 2, "nothing is published on the second run nor for the cancelled and ongoing events")
+}
@@ -12,6 +12,7 @@ import (
 	"html/template"
 	"maps"
 	"net/smtp"
+	"net/url"
 	"strings"
 	"time"
 
@@ -67,31 +68,39 @@ func (s *MailService) eventUrl(eventId uuid.UUID) string {
 	return fmt.Sprintf("%s/event/%s", s.Config.Origin, eventId.String())
 }
 
-// eventEmailCommonParams builds the shared parameter bag used by both event-confirmation and event-cancellation templates.
-func (s *MailService) eventEmailCommonParams(
-	event model.Event,
-	eventId uuid.UUID,
-	startsAt time.Time,
-	endsAt time.Time,
-	lang constants.AccountLanguage,
-	timeZone string,
-) map[string]string {
+// rsvpUrl links to the page answering with the status for the holder of the token, without signing in
+func (s *MailService) rsvpUrl(rsvpToken string, status constants.RsvpStatus) string {
+	return fmt.Sprintf("%s/rsvp?token=%s&status=%s", s.Config.Origin, url.QueryEscape(rsvpToken), status)
+}
+
+// formatWhen formats the range in the language and time zone of the account
+func formatWhen(startsAt time.Time, endsAt time.Time, lang constants.AccountLanguage, timeZone string) string {
 	loc, err := time.LoadLocation(timeZone)
 	if err != nil {
 		log.Error().
 			Str("timeZone", timeZone).
 			Err(err).
-			Msg("failed to load account time zone in eventEmailCommonParams, falling back to UTC")
+			Msg("failed to load account time zone in formatWhen, falling back to UTC")
 		loc = time.UTC
 	}
 
-	whenFormattedDateTime := lib.Capitalize(lib.FormatLocalizedDate(startsAt.In(loc), endsAt.In(loc), lang))
+	return lib.Capitalize(lib.FormatLocalizedDate(startsAt.In(loc), endsAt.In(loc), lang))
+}
 
+// eventEmailCommonParams builds the shared parameter bag used by both event-confirmation and event-cancellation templates.
+func (s *MailService) eventEmailCommonParams(
+	event model.Event,
+	eventId uuid.UUID,
+	startsAt time.Time,
+	endsAt time.Time,
+	lang constants.AccountLanguage,
+	timeZone string,
+) map[string]string {
 	return map[string]string{
 		"eventName":             event.Name,
 		"eventDescription":      "",
 		"eventUrl":              s.eventUrl(eventId),
-		"whenFormattedDateTime": whenFormattedDateTime,
+		"whenFormattedDateTime": formatWhen(startsAt, endsAt, lang, timeZone),
 	}
 }
 
@@ -110,9 +119,17 @@ func (s *MailService) eventEmailEnrichOptionalFields(
 	if event.Description != nil {
 		params["eventDescription"] = *event.Description
 	}
+	if event.Address != nil {
+		params["eventAddress"] = *event.Address
+	}
+	if event.MeetingUrl != nil {
+		params["eventMeetingUrl"] = *event.MeetingUrl
+	}
 }
 
 // SendEventConfirmationEmail sends the "event confirmed" email for a given participant.
+// The confirmation of a rescheduled event is sent as an update showing the previous slot.
+// The RSVP links are left out when rsvpToken is empty.
 func (s *MailService) SendEventConfirmationEmail(
 	participant model.Account,
 	event model.Event,
@@ -120,18 +137,35 @@ func (s *MailService) SendEventConfirmationEmail(
 	ownerId uuid.UUID,
 	startsAt time.Time,
 	endsAt time.Time,
+	rsvpToken string,
 ) {
 	if participant.Email == nil || participant.UserName == nil {
 		return
 	}
 
+	isUpdate := event.RescheduledAt != nil
 	subject := constants.MAIL_SUBJECT_EVENT_CONFIRMATION_EN
+	if isUpdate {
+		subject = constants.MAIL_SUBJECT_EVENT_RESCHEDULED_EN
+	}
 	if participant.Language == constants.ACCOUNT_LANGUAGE_FR {
 		subject = constants.MAIL_SUBJECT_EVENT_CONFIRMATION_FR
+		if isUpdate {
+			subject = constants.MAIL_SUBJECT_EVENT_RESCHEDULED_FR
+		}
 	}
 
 	params := s.eventEmailCommonParams(event, eventId, startsAt, endsAt, participant.Language, participant.TimeZone)
 	params["isOwner"] = lib.BoolToString(participant.Id == ownerId)
+	params["isUpdate"] = lib.BoolToString(isUpdate)
+	if previousSlot := event.GetPreviousSlot(); isUpdate && previousSlot != nil {
+		params["previousFormattedDateTime"] = formatWhen(previousSlot.StartsAt, previousSlot.EndsAt, participant.Language, participant.TimeZone)
+	}
+	if rsvpToken != "" {
+		params["rsvpAttendingUrl"] = s.rsvpUrl(rsvpToken, constants.RSVP_STATUS_ATTENDING)
+		params["rsvpTentativeUrl"] = s.rsvpUrl(rsvpToken, constants.RSVP_STATUS_TENTATIVE)
+		params["rsvpDeclinedUrl"] = s.rsvpUrl(rsvpToken, constants.RSVP_STATUS_DECLINED)
+	}
 
 	s.eventEmailEnrichOptionalFields(params, participant, event)
 
@@ -176,6 +210,394 @@ func (s *MailService) SendEventCancellationEmail(
 	})
 }
 
+// SendEventReschedulingEmail tells a participant that the validated slot was dropped and a new one is searched
+func (s *MailService) SendEventReschedulingEmail(
+	participant model.Account,
+	event model.Event,
+	reschedulerName string,
+	previousSlot model.Slot,
+	reason *string,
+	availabilitiesCleared bool,
+) {
+	if participant.Email == nil || participant.UserName == nil {
+		return
+	}
+
+	subject := constants.MAIL_SUBJECT_EVENT_RESCHEDULING_EN
+	if participant.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = constants.MAIL_SUBJECT_EVENT_RESCHEDULING_FR
+	}
+
+	params := s.eventEmailCommonParams(event, event.Id, previousSlot.StartsAt, previousSlot.EndsAt, participant.Language, participant.TimeZone)
+	params["reschedulerName"] = reschedulerName
+	params["refreshAvailabilities"] = lib.BoolToString(availabilitiesCleared)
+	if reason != nil {
+		params["reason"] = *reason
+	}
+
+	s.eventEmailEnrichOptionalFields(params, participant, event)
+
+	go s.SendMail(EmailParams{
+		Template: constants.MAIL_TEMPLATE_EVENT_RESCHEDULING,
+		To:       *participant.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: participant.Language,
+	})
+}
+
+// SendAvailabilityProxyEmail notifies a participant that an availability was changed on their behalf.
+func (s *MailService) SendAvailabilityProxyEmail(
+	participant model.Account,
+	event model.Event,
+	proxyName string,
+	change constants.AvailabilityChange,
+	startsAt time.Time,
+	endsAt time.Time,
+) {
+	if participant.Email == nil || participant.UserName == nil {
+		return
+	}
+
+	subject := constants.MAIL_SUBJECT_AVAILABILITY_PROXY_EN
+	if participant.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = constants.MAIL_SUBJECT_AVAILABILITY_PROXY_FR
+	}
+
+	params := s.eventEmailCommonParams(event, event.Id, startsAt, endsAt, participant.Language, participant.TimeZone)
+	params["proxy"] = proxyName
+	params["action"] = string(change)
+
+	s.eventEmailEnrichOptionalFields(params, participant, event)
+
+	go s.SendMail(EmailParams{
+		Template: constants.MAIL_TEMPLATE_AVAILABILITY_PROXY,
+		To:       *participant.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: participant.Language,
+	})
+}
+
+// SendJoinRequestEmail notifies the event owner that someone asked to join the event.
+func (s *MailService) SendJoinRequestEmail(owner model.Account, event model.Event, requesterName string) {
+	if owner.Email == nil || owner.UserName == nil {
+		return
+	}
+
+	subject := constants.MAIL_SUBJECT_JOIN_REQUEST_EN
+	if owner.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = constants.MAIL_SUBJECT_JOIN_REQUEST_FR
+	}
+
+	params := map[string]string{
+		"eventName": event.Name,
+		"eventUrl":  s.eventUrl(event.Id),
+		"requester": requesterName,
+	}
+
+	s.eventEmailEnrichOptionalFields(params, owner, event)
+
+	go s.SendMail(EmailParams{
+		Template: constants.MAIL_TEMPLATE_JOIN_REQUEST,
+		To:       *owner.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: owner.Language,
+	})
+}
+
+// SendOwnershipNominationEmail asks the nominee to accept the ownership of the event.
+func (s *MailService) SendOwnershipNominationEmail(nominee model.Account, event model.Event, ownerName string) {
+	if nominee.Email == nil || nominee.UserName == nil {
+		return
+	}
+
+	subject := constants.MAIL_SUBJECT_OWNERSHIP_NOMINATION_EN
+	if nominee.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = constants.MAIL_SUBJECT_OWNERSHIP_NOMINATION_FR
+	}
+
+	params := map[string]string{
+		"eventName": event.Name,
+		"eventUrl":  s.eventUrl(event.Id),
+	}
+
+	s.eventEmailEnrichOptionalFields(params, nominee, event)
+	params["owner"] = ownerName
+
+	go s.SendMail(EmailParams{
+		Template: constants.MAIL_TEMPLATE_OWNERSHIP_NOMINATION,
+		To:       *nominee.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: nominee.Language,
+	})
+}
+
+// SendOwnershipTransferredEmail tells a party of the transfer that the event has a new owner.
+func (s *MailService) SendOwnershipTransferredEmail(recipient model.Account, event model.Event, newOwnerName string, isNewOwner bool) {
+	if recipient.Email == nil || recipient.UserName == nil {
+		return
+	}
+
+	subject := constants.MAIL_SUBJECT_OWNERSHIP_TRANSFERRED_EN
+	if recipient.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = constants.MAIL_SUBJECT_OWNERSHIP_TRANSFERRED_FR
+	}
+
+	params := map[string]string{
+		"eventName":  event.Name,
+		"eventUrl":   s.eventUrl(event.Id),
+		"newOwner":   newOwnerName,
+		"isNewOwner": lib.BoolToString(isNewOwner),
+	}
+
+	s.eventEmailEnrichOptionalFields(params, recipient, event)
+
+	go s.SendMail(EmailParams{
+		Template: constants.MAIL_TEMPLATE_OWNERSHIP_TRANSFERRED,
+		To:       *recipient.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: recipient.Language,
+	})
+}
+
+// SendParticipantLeftEmail warns the event owner that a participant available for the confirmed slot left or was removed.
+func (s *MailService) SendParticipantLeftEmail(
+	owner model.Account,
+	event model.Event,
+	participantName string,
+	removed bool,
+	startsAt time.Time,
+	endsAt time.Time,
+) {
+	if owner.Email == nil || owner.UserName == nil {
+		return
+	}
+
+	subject := constants.MAIL_SUBJECT_PARTICIPANT_LEFT_EN
+	if owner.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = constants.MAIL_SUBJECT_PARTICIPANT_LEFT_FR
+	}
+
+	params := s.eventEmailCommonParams(event, event.Id, startsAt, endsAt, owner.Language, owner.TimeZone)
+	params["participant"] = participantName
+	params["removed"] = lib.BoolToString(removed)
+
+	s.eventEmailEnrichOptionalFields(params, owner, event)
+
+	go s.SendMail(EmailParams{
+		Template: constants.MAIL_TEMPLATE_PARTICIPANT_LEFT,
+		To:       *owner.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: owner.Language,
+	})
+}
+
+// SendEventCancelledEmail tells a member that the owner cancelled the event.
+// The confirmed slot is shown when there is one, otherwise the planned period of the event.
+func (s *MailService) SendEventCancelledEmail(
+	participant model.Account,
+	event model.Event,
+	reason *string,
+	validatedSlot *model.Slot,
+) {
+	if participant.Email == nil || participant.UserName == nil {
+		return
+	}
+
+	subject := constants.MAIL_SUBJECT_EVENT_CANCELLED_EN
+	if participant.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = constants.MAIL_SUBJECT_EVENT_CANCELLED_FR
+	}
+
+	startsAt, endsAt := event.StartsAt, event.EndsAt
+	if validatedSlot != nil {
+		startsAt, endsAt = validatedSlot.StartsAt, validatedSlot.EndsAt
+	}
+
+	params := s.eventEmailCommonParams(event, event.Id, startsAt, endsAt, participant.Language, participant.TimeZone)
+	params["isConfirmed"] = lib.BoolToString(validatedSlot != nil)
+	if reason != nil {
+		params["reason"] = *reason
+	}
+
+	s.eventEmailEnrichOptionalFields(params, participant, event)
+
+	go s.SendMail(EmailParams{
+		Template: constants.MAIL_TEMPLATE_EVENT_CANCELLED,
+		To:       *participant.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: participant.Language,
+	})
+}
+
+// SendDeadlineReminderEmail reminds a participant without availabilities that the decision deadline is close
+func (s *MailService) SendDeadlineReminderEmail(participant model.Account, event model.Event) {
+	s.sendDeadlineEmail(participant, event, constants.MAIL_TEMPLATE_DEADLINE_REMINDER, constants.MAIL_SUBJECT_DEADLINE_REMINDER_EN, constants.MAIL_SUBJECT_DEADLINE_REMINDER_FR)
+}
+
+// SendDeadlineMissedEmail tells the owner that the decision deadline passed without any slot to confirm
+func (s *MailService) SendDeadlineMissedEmail(owner model.Account, event model.Event) {
+	s.sendDeadlineEmail(owner, event, constants.MAIL_TEMPLATE_DEADLINE_MISSED, constants.MAIL_SUBJECT_DEADLINE_MISSED_EN, constants.MAIL_SUBJECT_DEADLINE_MISSED_FR)
+}
+
+func (s *MailService) sendDeadlineEmail(
+	recipient model.Account,
+	event model.Event,
+	template constants.MailTemplate,
+	subjectEn string,
+	subjectFr string,
+) {
+	if recipient.Email == nil || recipient.UserName == nil || event.DecisionDeadline == nil {
+		return
+	}
+
+	subject := subjectEn
+	if recipient.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = subjectFr
+	}
+
+	loc, err := time.LoadLocation(recipient.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	params := s.eventEmailCommonParams(event, event.Id, event.StartsAt, event.EndsAt, recipient.Language, recipient.TimeZone)
+	params["deadline"] = lib.Capitalize(lib.FormatLocalizedDateTime(event.DecisionDeadline.In(loc), recipient.Language))
+
+	s.eventEmailEnrichOptionalFields(params, recipient, event)
+
+	go s.SendMail(EmailParams{
+		Template: template,
+		To:       *recipient.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: recipient.Language,
+	})
+}
+
+// SendEventInvitationEmail tells a participant that the owner added them to a new event
+func (s *MailService) SendEventInvitationEmail(participant model.Account, event model.Event) {
+	if participant.Email == nil || participant.UserName == nil {
+		return
+	}
+
+	subject := constants.MAIL_SUBJECT_EVENT_INVITATION_EN
+	if participant.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = constants.MAIL_SUBJECT_EVENT_INVITATION_FR
+	}
+
+	params := s.eventEmailCommonParams(event, event.Id, event.StartsAt, event.EndsAt, participant.Language, participant.TimeZone)
+
+	s.eventEmailEnrichOptionalFields(params, participant, event)
+
+	go s.SendMail(EmailParams{
+		Template: constants.MAIL_TEMPLATE_EVENT_INVITATION,
+		To:       *participant.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: participant.Language,
+	})
+}
+
+// SendWaitlistPromotedEmail tells a waitlisted user that a seat opened up and that they are now a participant
+func (s *MailService) SendWaitlistPromotedEmail(participant model.Account, event model.Event) {
+	if participant.Email == nil || participant.UserName == nil {
+		return
+	}
+
+	subject := constants.MAIL_SUBJECT_WAITLIST_PROMOTED_EN
+	if participant.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = constants.MAIL_SUBJECT_WAITLIST_PROMOTED_FR
+	}
+
+	params := map[string]string{
+		"eventName": event.Name,
+		"eventUrl":  s.eventUrl(event.Id),
+	}
+
+	s.eventEmailEnrichOptionalFields(params, participant, event)
+
+	go s.SendMail(EmailParams{
+		Template: constants.MAIL_TEMPLATE_WAITLIST_PROMOTED,
+		To:       *participant.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: participant.Language,
+	})
+}
+
+// SendCommentMentionEmail tells a member that the author mentioned them in a comment of the event
+func (s *MailService) SendCommentMentionEmail(recipient model.Account, event model.Event, authorName string, body string) {
+	if recipient.Email == nil || recipient.UserName == nil {
+		return
+	}
+
+	subject := constants.MAIL_SUBJECT_COMMENT_MENTION_EN
+	if recipient.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = constants.MAIL_SUBJECT_COMMENT_MENTION_FR
+	}
+
+	params := map[string]string{
+		"eventName": event.Name,
+		"eventUrl":  s.eventUrl(event.Id),
+		"author":    authorName,
+		"comment":   body,
+	}
+
+	s.eventEmailEnrichOptionalFields(params, recipient, event)
+
+	go s.SendMail(EmailParams{
+		Template: constants.MAIL_TEMPLATE_COMMENT_MENTION,
+		To:       *recipient.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: recipient.Language,
+	})
+}
+
+// SendCommentDigestEmail sends the new comments of the event to a subscribed member, one "author: body" paragraph per comment
+func (s *MailService) SendCommentDigestEmail(recipient model.Account, event model.Event, comments []model.Comment) {
+	if recipient.Email == nil || recipient.UserName == nil || len(comments) == 0 {
+		return
+	}
+
+	subject := constants.MAIL_SUBJECT_COMMENT_DIGEST_EN
+	if recipient.Language == constants.ACCOUNT_LANGUAGE_FR {
+		subject = constants.MAIL_SUBJECT_COMMENT_DIGEST_FR
+	}
+
+	lines := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		author := ""
+		if comment.Author.UserName != nil {
+			author = *comment.Author.UserName
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", author, comment.Body))
+	}
+
+	params := map[string]string{
+		"eventName": event.Name,
+		"eventUrl":  s.eventUrl(event.Id),
+		"comments":  strings.Join(lines, "\n\n"),
+	}
+
+	s.eventEmailEnrichOptionalFields(params, recipient, event)
+
+	go s.SendMail(EmailParams{
+		Template: constants.MAIL_TEMPLATE_COMMENT_DIGEST,
+		To:       *recipient.Email,
+		Subject:  subject,
+		Params:   params,
+		Language: recipient.Language,
+	})
+}
+
 // loadTemplates loads all HTML templates from the templates directory
 func (s *MailService) loadTemplates() error {
 	templateFiles, err := templateFS.ReadDir("templates")
@@ -0,0 +1,29 @@
+package meeting
+
+import (
+	model "app/db/models"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+const jitsiRoomPrefix = "SlotFinder-"
+
+// JitsiGenerator builds room links for a Jitsi Meet server. Rooms are created by the server on first join,
+// so a hard to guess room name is enough.
+type JitsiGenerator struct {
+	baseUrl string
+}
+
+func NewJitsiGenerator(baseUrl string) *JitsiGenerator {
+	return &JitsiGenerator{baseUrl: strings.TrimRight(baseUrl, "/")}
+}
+
+func (g *JitsiGenerator) Generate(_ model.Event) (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return g.baseUrl + "/" + jitsiRoomPrefix + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,28 @@
+package meeting
+
+import (
+	"app/config"
+	model "app/db/models"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJitsiGenerator_BuildsRandomRoomUrl(t *testing.T) {
+	generator := NewJitsiGenerator("https://meet.example.com/")
+
+	first, err := generator.Generate(model.Event{})
+	assert.NoError(t, err)
+	second, err := generator.Generate(model.Event{})
+	assert.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(first, "https://meet.example.com/"+jitsiRoomPrefix))
+	assert.Len(t, strings.TrimPrefix(first, "https://meet.example.com/"+jitsiRoomPrefix), 24)
+	assert.NotEqual(t, first, second)
+}
+
+func TestNewLinkGenerator(t *testing.T) {
+	assert.Nil(t, NewLinkGenerator(config.MeetingConfiguration{}))
+	assert.IsType(t, &JitsiGenerator{}, NewLinkGenerator(config.MeetingConfiguration{JitsiBaseUrl: "https://meet.example.com"}))
+}
@@ -0,0 +1,20 @@
+package meeting
+
+import (
+	"app/config"
+	model "app/db/models"
+)
+
+// LinkGenerator creates the online meeting link of an event when one of its slots is confirmed
+type LinkGenerator interface {
+	Generate(event model.Event) (string, error)
+}
+
+// NewLinkGenerator returns the generator enabled by the configuration, or nil when none is configured
+func NewLinkGenerator(meetingConfig config.MeetingConfiguration) LinkGenerator {
+	if meetingConfig.JitsiBaseUrl != "" {
+		return NewJitsiGenerator(meetingConfig.JitsiBaseUrl)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package ownership
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	"app/commons/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OwnershipController struct {
+	ownershipService *OwnershipService
+}
+
+func NewOwnershipController(ctl *OwnershipController) *OwnershipController {
+	if ctl != nil {
+		return ctl
+	}
+
+	return &OwnershipController{
+		ownershipService: NewOwnershipService(nil),
+	}
+}
+
+func (ctl *OwnershipController) getEventIdParam(c *gin.Context) (uuid.UUID, error) {
+	eventId, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return uuid.Nil, err
+	}
+
+	return eventId, nil
+}
+
+// @Summary Nominate a new owner
+// @Description Owner only. The nominee becomes the owner once they accept, a new nomination replaces the previous one.
+// @Tags Ownership
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param data body OwnershipNominateDto true "Nomination parameters"
+// @Security BearerAuth
+// @Success 200 {object} OwnershipResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_PARTICIPANT_NOT_FOUND, or ERR_OWNERSHIP_NOMINEE_INVALID"
+// @Router /api/v1/events/{eventId}/ownership/nomination [post]
+func (ctl *OwnershipController) Nominate(c *gin.Context) {
+	var data OwnershipNominateDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.ownershipService.Nominate(eventId, &data, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary Cancel or decline an ownership nomination
+// @Description The owner withdraws the nomination, or the nominee declines it.
+// @Tags Ownership
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Security BearerAuth
+// @Success 200 {object} OwnershipResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, or ERR_OWNERSHIP_NOMINATION_NOT_FOUND"
+// @Router /api/v1/events/{eventId}/ownership/nomination [delete]
+func (ctl *OwnershipController) CancelNomination(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.ownershipService.CancelNomination(eventId, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary Accept an ownership nomination
+// @Description Nominee only. The nominee becomes the owner and the previous owner stays as organiser, both are emailed.
+// @Tags Ownership
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Security BearerAuth
+// @Success 200 {object} OwnershipResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_PARTICIPANT_NOT_FOUND, ERR_OWNERSHIP_NOMINEE_INVALID, or ERR_OWNERSHIP_NOMINATION_NOT_FOUND"
+// @Router /api/v1/events/{eventId}/ownership/accept [post]
+func (ctl *OwnershipController) Accept(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.ownershipService.Accept(eventId, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary Transfer the ownership of an orphaned event
+// @Description Admin only. Hands the event over to one of its members when the owner's account has been deleted.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param eventId path string true "Event Id"
+// @Param data body OwnershipAdminTransferDto true "Transfer parameters"
+// @Success 200 {object} OwnershipResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_ADMIN_ACCESS_DENIED, ERR_EVENT_NOT_FOUND, ERR_EVENT_PARTICIPANT_NOT_FOUND, ERR_OWNERSHIP_NOMINEE_INVALID, or ERR_OWNERSHIP_OWNER_ACCOUNT_ACTIVE"
+// @Router /api/v1/admin/events/{eventId}/ownership [post]
+func (ctl *OwnershipController) AdminTransfer(c *gin.Context) {
+	var data OwnershipAdminTransferDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.ownershipService.AdminTransfer(eventId, &data)
+	helpers.HandleJSONResponse(c, result, err)
+}
@@ -0,0 +1,13 @@
+package ownership
+
+import "github.com/google/uuid"
+
+// OwnershipNominateDto - POST /events/:id/ownership/nomination
+type OwnershipNominateDto struct {
+	NomineeId uuid.UUID `json:"nomineeId" binding:"required"`
+}
+
+// OwnershipAdminTransferDto - POST /admin/events/:id/ownership
+type OwnershipAdminTransferDto struct {
+	NewOwnerId uuid.UUID `json:"newOwnerId" binding:"required"`
+}
@@ -0,0 +1,10 @@
+package ownership
+
+import model "app/db/models"
+
+func MapToOwnershipResponseDto(e model.Event) OwnershipResponseDto {
+	return OwnershipResponseDto{
+		OwnerId:        e.OwnerId,
+		PendingOwnerId: e.PendingOwnerId,
+	}
+}
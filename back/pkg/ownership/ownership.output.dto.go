@@ -0,0 +1,9 @@
+package ownership
+
+import "github.com/google/uuid"
+
+// OwnershipResponseDto - ownership state of an event
+type OwnershipResponseDto struct {
+	OwnerId        uuid.UUID  `json:"ownerId"`
+	PendingOwnerId *uuid.UUID `json:"pendingOwnerId"`
+}
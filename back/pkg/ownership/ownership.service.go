@@ -0,0 +1,224 @@
+package ownership
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	model "app/db/models"
+	"app/db/repository"
+	"app/pkg/mail"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type OwnershipService struct {
+	eventRepository   *repository.EventRepository
+	accountRepository *repository.AccountRepository
+	mailService       *mail.MailService
+}
+
+func NewOwnershipService(service *OwnershipService) *OwnershipService {
+	if service != nil {
+		return service
+	}
+
+	return &OwnershipService{
+		eventRepository:   repository.NewEventRepository(nil),
+		accountRepository: repository.NewAccountRepository(nil),
+		mailService:       mail.NewMailService(nil),
+	}
+}
+
+// checkNominee validates that the member can become the owner of the event.
+// Guests cannot own events since they have no email nor password to sign back in.
+func checkNominee(event *model.Event, nomineeId uuid.UUID) error {
+	role, isMember := event.RoleOf(&nomineeId)
+	if !isMember {
+		return constants.ERR_EVENT_PARTICIPANT_NOT_FOUND.Err
+	}
+	if role == constants.EVENT_ROLE_OWNER {
+		return constants.ERR_OWNERSHIP_NOMINEE_INVALID.Err
+	}
+
+	for _, accountEvent := range event.AccountEvents {
+		if accountEvent.AccountId == nomineeId && accountEvent.Account.IsGuest {
+			return constants.ERR_OWNERSHIP_NOMINEE_INVALID.Err
+		}
+	}
+
+	return nil
+}
+
+// isNominee checks if the user has a pending nomination for the event
+func isNominee(event *model.Event, userId uuid.UUID) bool {
+	return event.PendingOwnerId != nil && *event.PendingOwnerId == userId
+}
+
+func (s *OwnershipService) getEvent(eventId uuid.UUID, event *model.Event) error {
+	if err := s.eventRepository.FindOneById(eventId, event); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Nominate asks a member to take over the event. A new nomination replaces the previous one.
+func (s *OwnershipService) Nominate(eventId uuid.UUID, data *OwnershipNominateDto, user *guard.Claims) (OwnershipResponseDto, error) {
+	var event model.Event
+	if err := s.getEvent(eventId, &event); err != nil {
+		return OwnershipResponseDto{}, err
+	}
+
+	if !event.Can(&user.Id, constants.EVENT_PERMISSION_ADMINISTER) {
+		return OwnershipResponseDto{}, constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+
+	if err := checkNominee(&event, data.NomineeId); err != nil {
+		return OwnershipResponseDto{}, err
+	}
+
+	if err := s.eventRepository.UpdateColumns(event.Id, map[string]any{"pending_owner_id": data.NomineeId}); err != nil {
+		return OwnershipResponseDto{}, err
+	}
+	event.PendingOwnerId = &data.NomineeId
+
+	var nominee model.Account
+	if err := s.accountRepository.FindOneById(data.NomineeId, &nominee); err != nil {
+		log.Error().Err(err).Str("eventId", event.Id.String()).Msg("OWNERSHIP_SERVICE::NOMINATE Failed to get nominee for nomination mail")
+	} else {
+		ownerName := ""
+		if user.Username != nil {
+			ownerName = *user.Username
+		}
+		go s.mailService.SendOwnershipNominationEmail(nominee, event, ownerName)
+	}
+
+	return MapToOwnershipResponseDto(event), nil
+}
+
+// CancelNomination withdraws the pending nomination, either by the owner or by the nominee declining it
+func (s *OwnershipService) CancelNomination(eventId uuid.UUID, user *guard.Claims) (OwnershipResponseDto, error) {
+	var event model.Event
+	if err := s.getEvent(eventId, &event); err != nil {
+		return OwnershipResponseDto{}, err
+	}
+
+	if !event.Can(&user.Id, constants.EVENT_PERMISSION_ADMINISTER) && !isNominee(&event, user.Id) {
+		return OwnershipResponseDto{}, constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+	if event.PendingOwnerId == nil {
+		return OwnershipResponseDto{}, constants.ERR_OWNERSHIP_NOMINATION_NOT_FOUND.Err
+	}
+
+	if err := s.eventRepository.UpdateColumns(event.Id, map[string]any{"pending_owner_id": nil}); err != nil {
+		return OwnershipResponseDto{}, err
+	}
+	event.PendingOwnerId = nil
+
+	return MapToOwnershipResponseDto(event), nil
+}
+
+// Accept makes the nominee the owner of the event, the previous owner stays as organiser
+func (s *OwnershipService) Accept(eventId uuid.UUID, user *guard.Claims) (OwnershipResponseDto, error) {
+	var event model.Event
+	if err := s.getEvent(eventId, &event); err != nil {
+		return OwnershipResponseDto{}, err
+	}
+
+	if !isNominee(&event, user.Id) {
+		return OwnershipResponseDto{}, constants.ERR_OWNERSHIP_NOMINATION_NOT_FOUND.Err
+	}
+
+	// The nominee may have left the event since the nomination
+	if err := checkNominee(&event, user.Id); err != nil {
+		return OwnershipResponseDto{}, err
+	}
+
+	previousOwnerId := event.OwnerId
+	if err := s.transfer(&event, user.Id); err != nil {
+		return OwnershipResponseDto{}, err
+	}
+
+	s.notifyTransfer(event, previousOwnerId)
+
+	return MapToOwnershipResponseDto(event), nil
+}
+
+// AdminTransfer hands the event over to a member when the owner's account has been deleted
+func (s *OwnershipService) AdminTransfer(eventId uuid.UUID, data *OwnershipAdminTransferDto) (OwnershipResponseDto, error) {
+	var event model.Event
+	if err := s.getEvent(eventId, &event); err != nil {
+		return OwnershipResponseDto{}, err
+	}
+
+	// Only deleted owners can be replaced without their consent
+	var owner model.Account
+	err := s.accountRepository.FindOneById(event.OwnerId, &owner)
+	if err == nil {
+		return OwnershipResponseDto{}, constants.ERR_OWNERSHIP_OWNER_ACCOUNT_ACTIVE.Err
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return OwnershipResponseDto{}, err
+	}
+
+	if err := checkNominee(&event, data.NewOwnerId); err != nil {
+		return OwnershipResponseDto{}, err
+	}
+
+	previousOwnerId := event.OwnerId
+	if err := s.transfer(&event, data.NewOwnerId); err != nil {
+		return OwnershipResponseDto{}, err
+	}
+
+	log.Info().
+		Str("eventId", event.Id.String()).
+		Str("previousOwnerId", previousOwnerId.String()).
+		Str("newOwnerId", data.NewOwnerId.String()).
+		Msg("OWNERSHIP_SERVICE::ADMIN_TRANSFER Ownership transferred by an administrator")
+
+	s.notifyTransfer(event, previousOwnerId)
+
+	return MapToOwnershipResponseDto(event), nil
+}
+
+// transfer updates the owner of the event and the roles of both parties
+func (s *OwnershipService) transfer(event *model.Event, newOwnerId uuid.UUID) error {
+	if err := s.eventRepository.TransferOwnership(event.Id, event.OwnerId, newOwnerId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_OWNERSHIP_NOMINATION_NOT_FOUND.Err
+		}
+		return err
+	}
+
+	event.OwnerId = newOwnerId
+	event.PendingOwnerId = nil
+
+	return nil
+}
+
+// notifyTransfer emails the new owner and, when the account still exists, the previous owner
+func (s *OwnershipService) notifyTransfer(event model.Event, previousOwnerId uuid.UUID) {
+	var newOwner model.Account
+	if err := s.accountRepository.FindOneById(event.OwnerId, &newOwner); err != nil {
+		log.Error().Err(err).Str("eventId", event.Id.String()).Msg("OWNERSHIP_SERVICE::NOTIFY_TRANSFER Failed to get new owner for transfer mail")
+		return
+	}
+
+	newOwnerName := ""
+	if newOwner.UserName != nil {
+		newOwnerName = *newOwner.UserName
+	}
+	event.Owner = newOwner
+
+	go s.mailService.SendOwnershipTransferredEmail(newOwner, event, newOwnerName, true)
+
+	var previousOwner model.Account
+	if err := s.accountRepository.FindOneById(previousOwnerId, &previousOwner); err == nil {
+		go s.mailService.SendOwnershipTransferredEmail(previousOwner, event, newOwnerName, false)
+	}
+}
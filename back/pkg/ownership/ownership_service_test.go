@@ -0,0 +1,51 @@
+package ownership
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckNominee(t *testing.T) {
+	ownerId := uuid.New()
+	organiserId := uuid.New()
+	guestId := uuid.New()
+	event := model.Event{
+		OwnerId: ownerId,
+		AccountEvents: []model.AccountEvent{
+			{AccountId: ownerId, Role: constants.EVENT_ROLE_OWNER},
+			{AccountId: organiserId, Role: constants.EVENT_ROLE_ORGANISER},
+			{AccountId: guestId, Account: model.Account{IsGuest: true}},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		nomineeId uuid.UUID
+		want      error
+	}{
+		{"member can be nominated", organiserId, nil},
+		{"owner cannot be nominated", ownerId, constants.ERR_OWNERSHIP_NOMINEE_INVALID.Err},
+		{"guest cannot be nominated", guestId, constants.ERR_OWNERSHIP_NOMINEE_INVALID.Err},
+		{"non member cannot be nominated", uuid.New(), constants.ERR_EVENT_PARTICIPANT_NOT_FOUND.Err},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, checkNominee(&event, tt.nomineeId))
+		})
+	}
+}
+
+func TestIsNominee(t *testing.T) {
+	nomineeId := uuid.New()
+	event := model.Event{}
+
+	assert.False(t, isNominee(&event, nomineeId), "No nomination is pending")
+
+	event.PendingOwnerId = &nomineeId
+	assert.True(t, isNominee(&event, nomineeId))
+	assert.False(t, isNominee(&event, uuid.New()))
+}
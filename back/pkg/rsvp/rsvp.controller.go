@@ -0,0 +1,125 @@
+package rsvp
+
+import (
+	"app/commons/constants"
+	"app/commons/guard"
+	"app/commons/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RsvpController struct {
+	rsvpService *RsvpService
+}
+
+func NewRsvpController(ctl *RsvpController) *RsvpController {
+	if ctl != nil {
+		return ctl
+	}
+
+	return &RsvpController{
+		rsvpService: NewRsvpService(nil),
+	}
+}
+
+func (ctl *RsvpController) getEventIdParam(c *gin.Context) (uuid.UUID, error) {
+	eventId, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_EVENT_NOT_FOUND.Err)
+		return uuid.Nil, err
+	}
+
+	return eventId, nil
+}
+
+// @Summary Answer for the validated slot
+// @Description Members tell whether they will attend the validated slot of an upcoming event. A new answer replaces the previous one and the owner receives the new counts over SSE.
+// @Tags RSVP
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Param data body RsvpAnswerDto true "Answer"
+// @Security BearerAuth
+// @Success 200 {object} RsvpResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND or ERR_RSVP_CLOSED"
+// @Router /api/v1/events/{eventId}/rsvp [put]
+func (ctl *RsvpController) Answer(c *gin.Context) {
+	var data RsvpAnswerDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.rsvpService.Answer(eventId, &data, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary List the answers for the validated slot
+// @Description Requires the MANAGE permission. Returns the answers of the members and the number of members per answer.
+// @Tags RSVP
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event Id"
+// @Security BearerAuth
+// @Success 200 {object} RsvpSummaryDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_EVENT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, or ERR_SLOT_NOT_FOUND"
+// @Router /api/v1/events/{eventId}/rsvps [get]
+func (ctl *RsvpController) List(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	eventId, err := ctl.getEventIdParam(c)
+	if err != nil {
+		return
+	}
+
+	result, err := ctl.rsvpService.List(eventId, user)
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary Get the slot of an RSVP link
+// @Description No authentication required, the signed token of the confirmation email identifies the member. Returns the validated slot and the current answer of the member.
+// @Tags RSVP
+// @Accept json
+// @Produce json
+// @Param token query string true "RSVP token"
+// @Success 200 {object} RsvpTokenResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_RSVP_TOKEN_INVALID"
+// @Router /api/v1/rsvp [get]
+func (ctl *RsvpController) GetByToken(c *gin.Context) {
+	result, err := ctl.rsvpService.GetByToken(c.Query("token"))
+	helpers.HandleJSONResponse(c, result, err)
+}
+
+// @Summary Answer through an RSVP link
+// @Description No authentication required, the signed token of the confirmation email identifies the member. A new answer replaces the previous one and the owner receives the new counts over SSE.
+// @Tags RSVP
+// @Accept json
+// @Produce json
+// @Param data body RsvpTokenAnswerDto true "Token and answer"
+// @Success 200 {object} RsvpResponseDto
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_RSVP_TOKEN_INVALID or ERR_RSVP_CLOSED"
+// @Router /api/v1/rsvp [put]
+func (ctl *RsvpController) AnswerByToken(c *gin.Context) {
+	var data RsvpTokenAnswerDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	result, err := ctl.rsvpService.AnswerByToken(&data)
+	helpers.HandleJSONResponse(c, result, err)
+}
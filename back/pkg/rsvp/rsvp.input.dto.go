@@ -0,0 +1,14 @@
+package rsvp
+
+import "app/commons/constants"
+
+// RsvpAnswerDto - PUT /events/:id/rsvp
+type RsvpAnswerDto struct {
+	Status constants.RsvpStatus `json:"status" binding:"required,oneof=ATTENDING DECLINED TENTATIVE"`
+}
+
+// RsvpTokenAnswerDto - PUT /rsvp (link of the confirmation email, no authentication)
+type RsvpTokenAnswerDto struct {
+	Token  string               `json:"token" binding:"required"`
+	Status constants.RsvpStatus `json:"status" binding:"required,oneof=ATTENDING DECLINED TENTATIVE"`
+}
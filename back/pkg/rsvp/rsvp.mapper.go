@@ -0,0 +1,25 @@
+package rsvp
+
+import model "app/db/models"
+
+func MapToRsvpResponseDto(r model.Rsvp) RsvpResponseDto {
+	return RsvpResponseDto{
+		AccountId: r.AccountId,
+		Status:    r.Status,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func MapToRsvpTokenResponseDto(slot model.Slot, rsvp *model.Rsvp) RsvpTokenResponseDto {
+	result := RsvpTokenResponseDto{
+		EventId:   slot.EventId,
+		EventName: slot.Event.Name,
+		StartsAt:  slot.StartsAt,
+		EndsAt:    slot.EndsAt,
+	}
+	if rsvp != nil {
+		result.Status = &rsvp.Status
+	}
+
+	return result
+}
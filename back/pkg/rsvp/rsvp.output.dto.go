@@ -0,0 +1,39 @@
+package rsvp
+
+import (
+	"app/commons/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RsvpResponseDto - answer of a member for the validated slot
+type RsvpResponseDto struct {
+	AccountId uuid.UUID            `json:"accountId"`
+	Status    constants.RsvpStatus `json:"status"`
+	UpdatedAt time.Time            `json:"updatedAt"`
+}
+
+// RsvpCountsDto - number of members per answer, also the SSE rsvp-counts payload
+type RsvpCountsDto struct {
+	Attending int `json:"attending"`
+	Tentative int `json:"tentative"`
+	Declined  int `json:"declined"`
+	NoAnswer  int `json:"noAnswer"`
+}
+
+// RsvpSummaryDto - GET /events/:id/rsvps
+type RsvpSummaryDto struct {
+	SlotId  uuid.UUID         `json:"slotId"`
+	Counts  RsvpCountsDto     `json:"counts"`
+	Answers []RsvpResponseDto `json:"answers"`
+}
+
+// RsvpTokenResponseDto - GET /rsvp (the slot of the link and the current answer of its holder)
+type RsvpTokenResponseDto struct {
+	EventId   uuid.UUID             `json:"eventId"`
+	EventName string                `json:"eventName"`
+	StartsAt  time.Time             `json:"startsAt"`
+	EndsAt    time.Time             `json:"endsAt"`
+	Status    *constants.RsvpStatus `json:"status"`
+}
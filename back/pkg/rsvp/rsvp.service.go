@@ -0,0 +1,251 @@
+package rsvp
+
+import (
+	"app/commons/constants"
+	"app/commons/encryption"
+	"app/commons/guard"
+	model "app/db/models"
+	"app/db/repository"
+	"app/pkg/sse"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// Signed text prefix, so that no other signed value of the app can be used as an RSVP token
+const tokenScope = "rsvp:"
+
+type RsvpService struct {
+	rsvpRepository  *repository.RsvpRepository
+	slotRepository  *repository.SlotRepository
+	eventRepository *repository.EventRepository
+	sseService      *sse.SSEService
+}
+
+func NewRsvpService(service *RsvpService) *RsvpService {
+	if service != nil {
+		return service
+	}
+
+	return &RsvpService{
+		rsvpRepository:  repository.NewRsvpRepository(nil),
+		slotRepository:  repository.NewSlotRepository(nil),
+		eventRepository: repository.NewEventRepository(nil),
+		sseService:      sse.GetSSEService(),
+	}
+}
+
+// NewToken returns the token letting the account answer for the validated slot without signing in.
+// The token is valid as long as the slot stays the validated slot of the event.
+func NewToken(slotId uuid.UUID, accountId uuid.UUID) (string, error) {
+	payload := slotId.String() + "." + accountId.String()
+	signature, err := encryption.Sign(tokenScope + payload)
+	if err != nil {
+		return "", err
+	}
+
+	return payload + "." + signature, nil
+}
+
+// parseToken checks the signature of a token made by NewToken and returns the slot and the account it was made for
+func parseToken(token string) (uuid.UUID, uuid.UUID, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || !encryption.Verify(tokenScope+parts[0]+"."+parts[1], parts[2]) {
+		return uuid.Nil, uuid.Nil, constants.ERR_RSVP_TOKEN_INVALID.Err
+	}
+
+	slotId, err := uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.Nil, uuid.Nil, constants.ERR_RSVP_TOKEN_INVALID.Err
+	}
+	accountId, err := uuid.Parse(parts[1])
+	if err != nil {
+		return uuid.Nil, uuid.Nil, constants.ERR_RSVP_TOKEN_INVALID.Err
+	}
+
+	return slotId, accountId, nil
+}
+
+// checkOpen returns ERR_RSVP_CLOSED once the members cannot answer for the validated slot anymore
+func checkOpen(event *model.Event, slot *model.Slot, now time.Time) error {
+	if event.Status != constants.EVENT_STATUS_UPCOMING || !slot.EndsAt.After(now) {
+		return constants.ERR_RSVP_CLOSED.Err
+	}
+
+	return nil
+}
+
+// countRsvps counts the answers of the current members, members who left are not counted
+func countRsvps(event *model.Event, rsvps []model.Rsvp) RsvpCountsDto {
+	statuses := make(map[uuid.UUID]constants.RsvpStatus, len(rsvps))
+	for _, rsvp := range rsvps {
+		statuses[rsvp.AccountId] = rsvp.Status
+	}
+
+	var counts RsvpCountsDto
+	for _, accountEvent := range event.AccountEvents {
+		switch statuses[accountEvent.AccountId] {
+		case constants.RSVP_STATUS_ATTENDING:
+			counts.Attending++
+		case constants.RSVP_STATUS_TENTATIVE:
+			counts.Tentative++
+		case constants.RSVP_STATUS_DECLINED:
+			counts.Declined++
+		default:
+			counts.NoAnswer++
+		}
+	}
+
+	return counts
+}
+
+// getTokenSlot returns the validated slot the token was made for and the account holding it
+func (s *RsvpService) getTokenSlot(token string, slot *model.Slot) (uuid.UUID, error) {
+	slotId, accountId, err := parseToken(token)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// The slot is gone when the event was rescheduled or reopened
+	if err := s.slotRepository.FindOneById(slotId, slot); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, constants.ERR_RSVP_TOKEN_INVALID.Err
+		}
+		return uuid.Nil, err
+	}
+	if !slot.IsValidated {
+		return uuid.Nil, constants.ERR_RSVP_TOKEN_INVALID.Err
+	}
+	if _, isMember := slot.Event.RoleOf(&accountId); !isMember {
+		return uuid.Nil, constants.ERR_RSVP_TOKEN_INVALID.Err
+	}
+
+	return accountId, nil
+}
+
+// getMemberEvent returns the event with its validated slot when the user is a member
+func (s *RsvpService) getMemberEvent(eventId uuid.UUID, userId uuid.UUID, event *model.Event) (*model.Slot, error) {
+	if err := s.eventRepository.FindOneById(eventId, event); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constants.ERR_EVENT_NOT_FOUND.Err
+		}
+		return nil, err
+	}
+
+	if _, isMember := event.RoleOf(&userId); !isMember {
+		return nil, constants.ERR_EVENT_NOT_FOUND.Err
+	}
+
+	return event.GetValidatedSlot(), nil
+}
+
+// save records the answer and sends the new counts to the owner over SSE
+func (s *RsvpService) save(event *model.Event, slotId uuid.UUID, accountId uuid.UUID, status constants.RsvpStatus) (RsvpResponseDto, error) {
+	rsvp := model.Rsvp{
+		SlotId:    slotId,
+		AccountId: accountId,
+		EventId:   event.Id,
+		Status:    status,
+	}
+	if err := s.rsvpRepository.Save(&rsvp); err != nil {
+		return RsvpResponseDto{}, err
+	}
+
+	var rsvps []model.Rsvp
+	if err := s.rsvpRepository.FindBySlotId(slotId, &rsvps); err != nil {
+		log.Error().Err(err).Str("eventId", event.Id.String()).Msg("RSVP_SERVICE::SAVE Failed to get rsvps for the live counts")
+	} else {
+		s.sseService.SendToUser(event.Id, event.OwnerId, constants.SSE_EVENT_RSVP_COUNTS, countRsvps(event, rsvps))
+	}
+
+	return MapToRsvpResponseDto(rsvp), nil
+}
+
+// GetByToken returns the slot of the link with the current answer of its holder
+func (s *RsvpService) GetByToken(token string) (RsvpTokenResponseDto, error) {
+	var slot model.Slot
+	accountId, err := s.getTokenSlot(token, &slot)
+	if err != nil {
+		return RsvpTokenResponseDto{}, err
+	}
+
+	var rsvp model.Rsvp
+	if err := s.rsvpRepository.FindOneBySlotAndAccountId(slot.Id, accountId, &rsvp); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return RsvpTokenResponseDto{}, err
+		}
+		return MapToRsvpTokenResponseDto(slot, nil), nil
+	}
+
+	return MapToRsvpTokenResponseDto(slot, &rsvp), nil
+}
+
+// AnswerByToken records the answer of the holder of the link, no authentication is required
+func (s *RsvpService) AnswerByToken(data *RsvpTokenAnswerDto) (RsvpResponseDto, error) {
+	var slot model.Slot
+	accountId, err := s.getTokenSlot(data.Token, &slot)
+	if err != nil {
+		return RsvpResponseDto{}, err
+	}
+
+	if err := checkOpen(&slot.Event, &slot, time.Now()); err != nil {
+		return RsvpResponseDto{}, err
+	}
+
+	return s.save(&slot.Event, slot.Id, accountId, data.Status)
+}
+
+// Answer records the answer of the member for the validated slot of the event
+func (s *RsvpService) Answer(eventId uuid.UUID, data *RsvpAnswerDto, user *guard.Claims) (RsvpResponseDto, error) {
+	var event model.Event
+	slot, err := s.getMemberEvent(eventId, user.Id, &event)
+	if err != nil {
+		return RsvpResponseDto{}, err
+	}
+	if slot == nil {
+		return RsvpResponseDto{}, constants.ERR_RSVP_CLOSED.Err
+	}
+
+	if err := checkOpen(&event, slot, time.Now()); err != nil {
+		return RsvpResponseDto{}, err
+	}
+
+	return s.save(&event, slot.Id, user.Id, data.Status)
+}
+
+// List returns the answers of the members for the validated slot with the counts per answer
+func (s *RsvpService) List(eventId uuid.UUID, user *guard.Claims) (RsvpSummaryDto, error) {
+	var event model.Event
+	slot, err := s.getMemberEvent(eventId, user.Id, &event)
+	if err != nil {
+		return RsvpSummaryDto{}, err
+	}
+	if !event.Can(&user.Id, constants.EVENT_PERMISSION_MANAGE) {
+		return RsvpSummaryDto{}, constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+	if slot == nil {
+		return RsvpSummaryDto{}, constants.ERR_SLOT_NOT_FOUND.Err
+	}
+
+	var rsvps []model.Rsvp
+	if err := s.rsvpRepository.FindBySlotId(slot.Id, &rsvps); err != nil {
+		return RsvpSummaryDto{}, err
+	}
+
+	answers := make([]RsvpResponseDto, 0, len(rsvps))
+	for _, rsvp := range rsvps {
+		if _, isMember := event.RoleOf(&rsvp.AccountId); isMember {
+			answers = append(answers, MapToRsvpResponseDto(rsvp))
+		}
+	}
+
+	return RsvpSummaryDto{
+		SlotId:  slot.Id,
+		Counts:  countRsvps(&event, rsvps),
+		Answers: answers,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package rsvp
+
+import (
+	"app/commons/constants"
+	model "app/db/models"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenRoundTrip(t *testing.T) {
+	t.Setenv("ENCRYPTION_KEY", "1234567890abcdef")
+	slotId := uuid.New()
+	accountId := uuid.New()
+
+	token, err := NewToken(slotId, accountId)
+	assert.NoError(t, err)
+
+	parsedSlotId, parsedAccountId, err := parseToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, slotId, parsedSlotId)
+	assert.Equal(t, accountId, parsedAccountId)
+
+	// Answering for another member must break the signature
+	forged := strings.Replace(token, accountId.String(), uuid.New().String(), 1)
+	_, _, err = parseToken(forged)
+	assert.Equal(t, constants.ERR_RSVP_TOKEN_INVALID.Err, err)
+
+	_, _, err = parseToken("not-a-token")
+	assert.Equal(t, constants.ERR_RSVP_TOKEN_INVALID.Err, err)
+}
+
+func TestCheckOpen(t *testing.T) {
+	now := time.Now()
+	slot := model.Slot{StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}
+
+	tests := []struct {
+		name   string
+		status constants.EventStatus
+		now    time.Time
+		want   error
+	}{
+		{"upcoming event is open", constants.EVENT_STATUS_UPCOMING, now, nil},
+		{"started slot is still open", constants.EVENT_STATUS_UPCOMING, now.Add(90 * time.Minute), nil},
+		{"ended slot is closed", constants.EVENT_STATUS_UPCOMING, now.Add(2 * time.Hour), constants.ERR_RSVP_CLOSED.Err},
+		{"cancelled event is closed", constants.EVENT_STATUS_CANCELLED, now, constants.ERR_RSVP_CLOSED.Err},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, checkOpen(&model.Event{Status: tt.status}, &slot, tt.now))
+		})
+	}
+}
+
+func TestCountRsvps(t *testing.T) {
+	ownerId := uuid.New()
+	attendingId := uuid.New()
+	declinedId := uuid.New()
+	silentId := uuid.New()
+	event := model.Event{
+		OwnerId: ownerId,
+		AccountEvents: []model.AccountEvent{
+			{AccountId: ownerId, Role: constants.EVENT_ROLE_OWNER},
+			{AccountId: attendingId},
+			{AccountId: declinedId},
+			{AccountId: silentId},
+		},
+	}
+	rsvps := []model.Rsvp{
+		{AccountId: ownerId, Status: constants.RSVP_STATUS_TENTATIVE},
+		{AccountId: attendingId, Status: constants.RSVP_STATUS_ATTENDING},
+		{AccountId: declinedId, Status: constants.RSVP_STATUS_DECLINED},
+		// Answer of a member who left the event
+		{AccountId: uuid.New(), Status: constants.RSVP_STATUS_ATTENDING},
+	}
+
+	assert.Equal(t, RsvpCountsDto{Attending: 1, Tentative: 1, Declined: 1, NoAnswer: 1}, countRsvps(&event, rsvps))
+}
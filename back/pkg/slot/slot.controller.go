@@ -31,7 +31,8 @@ func NewSlotController(ctl *SlotController) *SlotController {
 // @Security BearerAuth
 // @Param data body ConfirmSlotDto true "Confirm Slot parameters"
 // @Success 200 {object} SlotResponseDto
-// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_SLOT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, ERR_SLOT_INVALID_STARTS_AT, or ERR_SLOT_INVALID_ENDS_AT"
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_SLOT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, ERR_EVENT_CANCELLED, ERR_SLOT_INVALID_STARTS_AT, or ERR_SLOT_INVALID_ENDS_AT"
+// @Failure 409 {object} helpers.ApiError "Conflict - Code can be: ERR_EVENT_VERSION_CONFLICT when the event was confirmed or changed concurrently"
 // @Router /api/v1/slots/{slotId}/confirm [post]
 func (ctl *SlotController) ConfirmSlot(c *gin.Context) {
 	var user *guard.Claims
@@ -62,7 +63,7 @@ func (ctl *SlotController) ConfirmSlot(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Success 200
-// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_SLOT_NOT_FOUND or ERR_EVENT_ACCESS_DENIED"
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_SLOT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, or ERR_EVENT_CANCELLED"
 // @Router /api/v1/slots/{slotId} [delete]
 func (ctl *SlotController) RemoveValidatedSlot(c *gin.Context) {
 	var user *guard.Claims
@@ -80,3 +81,37 @@ func (ctl *SlotController) RemoveValidatedSlot(c *gin.Context) {
 	err = ctl.slotService.RemoveValidatedSlot(slotId, user.Id)
 	helpers.HandleJSONResponse(c, nil, err)
 }
+
+// @Summary Reschedule a confirmed event
+// @Description Requires the MANAGE permission. Drops the validated slot of an upcoming event and searches a new one: the dropped slot stays visible as the previous slot, the other members receive a rescheduling email and the next confirmation is sent as an update. With refreshAvailabilities, the availabilities of the members are cleared so that they enter them again.
+// @Tags Slot
+// @Param slotId path string true "Slot Id"
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body RescheduleSlotDto true "Reschedule parameters"
+// @Success 200
+// @Failure 400 {object} helpers.ApiError "Bad Request - Code can be: ERR_SLOT_NOT_FOUND, ERR_EVENT_ACCESS_DENIED, ERR_EVENT_ENDED, or ERR_EVENT_CANCELLED"
+// @Failure 409 {object} helpers.ApiError "Conflict - Code can be: ERR_EVENT_VERSION_CONFLICT when the event was rescheduled or changed concurrently"
+// @Router /api/v1/slots/{slotId}/reschedule [post]
+func (ctl *SlotController) Reschedule(c *gin.Context) {
+	var user *guard.Claims
+	if err := guard.GetUserClaims(c, &user); err != nil {
+		helpers.HandleJSONResponse(c, nil, err)
+		return
+	}
+
+	var data RescheduleSlotDto
+	if err := helpers.SetHttpContextBody(c, &data); err != nil {
+		return
+	}
+
+	slotId, err := uuid.Parse(c.Param("slotId"))
+	if err != nil {
+		helpers.HandleJSONResponse(c, nil, constants.ERR_SLOT_NOT_FOUND.Err)
+		return
+	}
+
+	err = ctl.slotService.Reschedule(slotId, &data, user)
+	helpers.HandleJSONResponse(c, nil, err)
+}
@@ -8,3 +8,10 @@ type ConfirmSlotDto struct {
 	StartsAt time.Time `json:"startsAt" binding:"required"`
 	EndsAt   time.Time `json:"endsAt" binding:"required"`
 }
+
+// RescheduleSlotDto - POST /slots/:id/reschedule
+type RescheduleSlotDto struct {
+	// Clear the availabilities of the members so that they enter them again
+	RefreshAvailabilities bool    `json:"refreshAvailabilities"`
+	Reason                *string `json:"reason" binding:"omitempty,max=500"`
+}
@@ -2,19 +2,28 @@ package slot
 
 import (
 	"app/commons/constants"
+	"app/commons/guard"
 	"app/config"
 	model "app/db/models"
 	"app/db/repository"
+	"app/pkg/domainevent"
+	"app/pkg/lifecycle"
 	"app/pkg/mail"
+	"app/pkg/meeting"
+	"app/pkg/rsvp"
 	"app/pkg/sse"
+	"errors"
 	"sort"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
 )
 
+const gridInterval = 5 * time.Minute // Availabilities, and so slots, are aligned on this grid
+
 type SlotService struct {
 	slotRepository         *repository.SlotRepository
 	eventRepository        *repository.EventRepository
@@ -22,6 +31,9 @@ type SlotService struct {
 	accountEventRepository *repository.AccountEventRepository
 	sseService             *sse.SSEService
 	mailService            *mail.MailService
+	lifecycleService       *lifecycle.LifecycleService
+	domainEventBus         *domainevent.Bus
+	meetingLinkGenerator   meeting.LinkGenerator // nil when no generator is configured
 	config                 *config.Config
 	loadSlotsMutexes       sync.Map // Map of eventId to *sync.Mutex for preventing concurrent LoadSlots
 }
@@ -38,6 +50,9 @@ func NewSlotService(service *SlotService) *SlotService {
 		accountEventRepository: repository.NewAccountEventRepository(nil),
 		sseService:             sse.GetSSEService(),
 		mailService:            mail.NewMailService(nil),
+		lifecycleService:       lifecycle.NewLifecycleService(nil),
+		domainEventBus:         domainevent.GetBus(),
+		meetingLinkGenerator:   meeting.NewLinkGenerator(config.GetConfig().Meeting),
 		loadSlotsMutexes:       sync.Map{},
 		config:                 config.GetConfig(),
 	}
@@ -55,17 +70,96 @@ func (s *SlotService) ConfirmSlot(dto ConfirmSlotDto, slotId uuid.UUID, userId u
 		return SlotResponseDto{}, constants.ERR_SLOT_NOT_FOUND.Err
 	}
 
-	// Check if user is admin of the event
-	if !selectedSlot.Event.IsOwner(&userId) {
+	// Check if user can manage the event
+	if !selectedSlot.Event.Can(&userId, constants.EVENT_PERMISSION_MANAGE) {
 		return SlotResponseDto{}, constants.ERR_EVENT_ACCESS_DENIED.Err
 	}
 
+	return s.confirm(selectedSlot, dto, &userId)
+}
+
+// ConfirmBestSlot confirms the best slot of the event on behalf of its owner, for the length of the event duration.
+// Returns nil when the event has no slot to confirm.
+func (s *SlotService) ConfirmBestSlot(eventId uuid.UUID) (*SlotResponseDto, error) {
+	var event model.Event
+	if err := s.eventRepository.FindOneById(eventId, &event); err != nil {
+		return nil, err
+	}
+
+	best, startsAt := bestSlot(event.Slots, time.Duration(event.Duration)*time.Minute, time.Now())
+	if best == nil {
+		return nil, nil
+	}
+
+	result, err := s.confirmFrom(best.Id, startsAt)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// confirmFromStart confirms the slot from its start for the length of the event duration, without permission checks
+func (s *SlotService) confirmFromStart(slotId uuid.UUID) (SlotResponseDto, error) {
+	return s.confirmFrom(slotId, time.Time{})
+}
+
+// confirmFrom confirms the slot from startsAt, or from its start when startsAt is before it,
+// for the length of the event duration, without permission checks
+func (s *SlotService) confirmFrom(slotId uuid.UUID, startsAt time.Time) (SlotResponseDto, error) {
+	var selectedSlot model.Slot
+	if err := s.slotRepository.FindOneById(slotId, &selectedSlot); err != nil {
+		return SlotResponseDto{}, constants.ERR_SLOT_NOT_FOUND.Err
+	}
+
+	if startsAt.Before(selectedSlot.StartsAt) {
+		startsAt = selectedSlot.StartsAt
+	}
+	endsAt := startsAt.Add(time.Duration(selectedSlot.Event.Duration) * time.Minute)
+	if endsAt.After(selectedSlot.EndsAt) {
+		endsAt = selectedSlot.EndsAt
+	}
+
+	return s.confirm(selectedSlot, ConfirmSlotDto{StartsAt: startsAt, EndsAt: endsAt}, nil)
+}
+
+// bestSlot returns the earliest slot where the duration still fits after now, along with the start to confirm it from:
+// the start of the slot, or now rounded up to the grid when the slot has already started.
+// Every slot suits all the contributing members, so the earliest one is the best.
+func bestSlot(slots []model.Slot, duration time.Duration, now time.Time) (*model.Slot, time.Time) {
+	earliestStart := now.Truncate(gridInterval)
+	if earliestStart.Before(now) {
+		earliestStart = earliestStart.Add(gridInterval)
+	}
+
+	var best *model.Slot
+	var bestStartsAt time.Time
+	for i := range slots {
+		if slots[i].IsValidated {
+			continue
+		}
+		startsAt := slots[i].StartsAt
+		if startsAt.Before(earliestStart) {
+			startsAt = earliestStart
+		}
+		if slots[i].EndsAt.Sub(startsAt) < duration {
+			continue
+		}
+		if best == nil || startsAt.Before(bestStartsAt) {
+			best, bestStartsAt = &slots[i], startsAt
+		}
+	}
+
+	return best, bestStartsAt
+}
+
+// confirm validates the range within the selected slot, then confirms it and notifies the participants
+func (s *SlotService) confirm(selectedSlot model.Slot, dto ConfirmSlotDto, actorId *uuid.UUID) (SlotResponseDto, error) {
 	// Check if event is locked
-	if hasStatus, err := selectedSlot.Event.CheckAndAutoUpdateStatus(s.eventRepository.Updates, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION}); !hasStatus || err != nil {
+	if hasStatus, err := selectedSlot.Event.CheckAndAutoUpdateStatus(s.lifecycleService.Finish, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION}); !hasStatus || err != nil {
 		if err != nil {
 			return SlotResponseDto{}, err
 		}
-		return SlotResponseDto{}, constants.ERR_EVENT_ENDED.Err
+		return SlotResponseDto{}, selectedSlot.Event.LockedError()
 	}
 
 	// Check if dto StartsAt is equals or after selectedSlot.StartsAt and before selectedSlot.EndsAt
@@ -85,18 +179,22 @@ func (s *SlotService) ConfirmSlot(dto ConfirmSlotDto, slotId uuid.UUID, userId u
 		EndsAt:      dto.EndsAt,
 		IsValidated: true,
 	}
-	if err := s.slotRepository.Create(&slot); err != nil {
+	// The event moves to UPCOMING along with the slot creation, and the reschedule if any ends.
+	// A concurrent confirmation or change of the event since it was loaded makes it fail.
+	if err := s.slotRepository.CreateValidated(&slot, selectedSlot.Event.Version); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return SlotResponseDto{}, constants.ERR_EVENT_VERSION_CONFLICT.Err
+		}
 		return SlotResponseDto{}, err
 	}
+	s.domainEventBus.Publish(domainevent.NewChange(constants.DOMAIN_EVENT_SLOT_CONFIRMED, slot.EventId, actorId, nil, domainevent.FieldsOf(slot)))
+	s.domainEventBus.Publish(domainevent.NewStatusChanged(slot.EventId, constants.EVENT_STATUS_IN_DECISION, constants.EVENT_STATUS_UPCOMING, actorId))
+	event := model.Event{Id: selectedSlot.EventId}
 
-	// Update event status
-	event := model.Event{
-		Id:     selectedSlot.EventId,
-		Status: constants.EVENT_STATUS_UPCOMING,
-	}
-	if err := s.eventRepository.Updates(&event); err != nil {
-		return SlotResponseDto{}, err
-	}
+	// The confirmation emails need the full event, including its meeting link
+	confirmedEvent := selectedSlot.Event
+	confirmedEvent.Status = constants.EVENT_STATUS_UPCOMING
+	s.generateMeetingLink(&confirmedEvent)
 
 	// Send event confirmation emails to all participants (including owner)
 	var participants []model.Account
@@ -104,13 +202,20 @@ func (s *SlotService) ConfirmSlot(dto ConfirmSlotDto, slotId uuid.UUID, userId u
 		log.Error().Err(err).Str("eventId", event.Id.String()).Msg("Failed to get participants for event confirmation mail")
 	} else {
 		for _, participant := range participants {
+			// Without a token the email is still sent, only the RSVP links are left out
+			rsvpToken, err := rsvp.NewToken(slot.Id, participant.Id)
+			if err != nil {
+				log.Error().Err(err).Str("eventId", event.Id.String()).Msg("Failed to sign the RSVP token for event confirmation mail")
+			}
+
 			go s.mailService.SendEventConfirmationEmail(
 				participant,
-				event,
+				confirmedEvent,
 				event.Id,
 				event.OwnerId,
 				slot.StartsAt,
 				slot.EndsAt,
+				rsvpToken,
 			)
 		}
 	}
@@ -118,14 +223,33 @@ func (s *SlotService) ConfirmSlot(dto ConfirmSlotDto, slotId uuid.UUID, userId u
 	return MapToSlotResponseDto(slot), nil
 }
 
+// generateMeetingLink sets a generated meeting link on events asking for one that have none yet.
+// A failure is only logged, the slot stays confirmed without a link.
+func (s *SlotService) generateMeetingLink(event *model.Event) {
+	if !event.AutoMeetingLink || event.MeetingUrl != nil || s.meetingLinkGenerator == nil {
+		return
+	}
+
+	link, err := s.meetingLinkGenerator.Generate(*event)
+	if err != nil {
+		log.Error().Err(err).Str("eventId", event.Id.String()).Msg("SLOT_SERVICE::GENERATE_MEETING_LINK Failed to generate meeting link")
+		return
+	}
+	if err := s.eventRepository.UpdateColumns(event.Id, map[string]any{"meeting_url": link}); err != nil {
+		return
+	}
+
+	event.MeetingUrl = &link
+}
+
 func (s *SlotService) RemoveValidatedSlot(slotId uuid.UUID, userId uuid.UUID) error {
 	var selectedSlot model.Slot
 	if err := s.slotRepository.FindOneById(slotId, &selectedSlot); err != nil {
 		return constants.ERR_SLOT_NOT_FOUND.Err
 	}
 
-	// Check if user is admin of the event
-	if !selectedSlot.Event.IsOwner(&userId) {
+	// Check if user can manage the event
+	if !selectedSlot.Event.Can(&userId, constants.EVENT_PERMISSION_MANAGE) {
 		return constants.ERR_EVENT_ACCESS_DENIED.Err
 	}
 
@@ -133,6 +257,11 @@ func (s *SlotService) RemoveValidatedSlot(slotId uuid.UUID, userId uuid.UUID) er
 		return constants.ERR_SLOT_NOT_FOUND.Err
 	}
 
+	// Cancelled events keep their slot, removing it would reopen the event
+	if selectedSlot.Event.Status == constants.EVENT_STATUS_CANCELLED {
+		return constants.ERR_EVENT_CANCELLED.Err
+	}
+
 	// Remove validated slot
 	err := s.slotRepository.DeleteValidatedSlotByEventId(selectedSlot.EventId)
 	if err != nil {
@@ -147,6 +276,10 @@ func (s *SlotService) RemoveValidatedSlot(slotId uuid.UUID, userId uuid.UUID) er
 	if err := s.eventRepository.Updates(&event); err != nil {
 		return err
 	}
+	s.domainEventBus.Publish(domainevent.NewChange(constants.DOMAIN_EVENT_SLOT_REMOVED, event.Id, &userId, domainevent.FieldsOf(selectedSlot), nil))
+	if selectedSlot.Event.Status != event.Status {
+		s.domainEventBus.Publish(domainevent.NewStatusChanged(event.Id, selectedSlot.Event.Status, event.Status, &userId))
+	}
 
 	// Send cancellation emails
 	var participants []model.Account
@@ -171,6 +304,65 @@ func (s *SlotService) RemoveValidatedSlot(slotId uuid.UUID, userId uuid.UUID) er
 	return nil
 }
 
+// Reschedule drops the validated slot of an upcoming event to search a new one. The dropped slot is kept on the event
+// as the previous slot, the members are told that the event is being rescheduled and the next confirmation is sent as an update.
+// When asked, the availabilities of the members are cleared so that they enter them again.
+func (s *SlotService) Reschedule(slotId uuid.UUID, data *RescheduleSlotDto, user *guard.Claims) error {
+	var selectedSlot model.Slot
+	if err := s.slotRepository.FindOneById(slotId, &selectedSlot); err != nil {
+		return constants.ERR_SLOT_NOT_FOUND.Err
+	}
+	event := selectedSlot.Event
+
+	if !event.Can(&user.Id, constants.EVENT_PERMISSION_MANAGE) {
+		return constants.ERR_EVENT_ACCESS_DENIED.Err
+	}
+	if !selectedSlot.IsValidated {
+		return constants.ERR_SLOT_NOT_FOUND.Err
+	}
+
+	// Only upcoming events can move, finished and cancelled ones stay as they are
+	if hasStatus, err := event.CheckAndAutoUpdateStatus(s.lifecycleService.Finish, &[]constants.EventStatus{constants.EVENT_STATUS_UPCOMING}); !hasStatus || err != nil {
+		if err != nil {
+			return err
+		}
+		return event.LockedError()
+	}
+
+	// The slot is dropped, the event moves back to IN_DECISION and the availabilities are cleared if asked all at once.
+	// A concurrent change of the event since it was loaded makes it fail.
+	if err := s.slotRepository.Reschedule(selectedSlot, event.Version, time.Now().UTC(), data.RefreshAvailabilities); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.ERR_EVENT_VERSION_CONFLICT.Err
+		}
+		return err
+	}
+	s.domainEventBus.Publish(domainevent.NewChange(constants.DOMAIN_EVENT_SLOT_REMOVED, event.Id, &user.Id, domainevent.FieldsOf(selectedSlot), nil))
+	s.domainEventBus.Publish(domainevent.NewStatusChanged(event.Id, constants.EVENT_STATUS_UPCOMING, constants.EVENT_STATUS_IN_DECISION, &user.Id))
+
+	// Send rescheduling emails to the other members
+	reschedulerName := ""
+	if user.Username != nil {
+		reschedulerName = *user.Username
+	}
+	var participants []model.Account
+	if err := s.accountEventRepository.FindAccountsByEventId(event.Id, &participants); err != nil {
+		log.Error().Err(err).Str("eventId", event.Id.String()).Msg("Failed to get participants for event rescheduling mail")
+	} else {
+		for _, participant := range participants {
+			if participant.Id == user.Id {
+				continue
+			}
+			go s.mailService.SendEventReschedulingEmail(participant, event, reschedulerName, selectedSlot, data.Reason, data.RefreshAvailabilities)
+		}
+	}
+
+	// Recalculate slots
+	go s.LoadSlots(event.Id)
+
+	return nil
+}
+
 // Recalculates and recreates all slots for an event
 func (s *SlotService) LoadSlots(eventId uuid.UUID) {
 	// Acquire per-event mutex to prevent concurrent slot recalculations for the same event
@@ -190,7 +382,7 @@ func (s *SlotService) LoadSlots(eventId uuid.UUID) {
 	}
 
 	// If event is finished, do not recalculate slots
-	if hasStatus, err := event.CheckAndAutoUpdateStatus(s.eventRepository.Updates, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION}); !hasStatus || err != nil {
+	if hasStatus, err := event.CheckAndAutoUpdateStatus(s.lifecycleService.Finish, &[]constants.EventStatus{constants.EVENT_STATUS_IN_DECISION}); !hasStatus || err != nil {
 		if err != nil {
 			log.Error().Err(err).Str("eventId", eventId.String()).Msg("Failed to check event status")
 		} else {
@@ -213,16 +405,7 @@ func (s *SlotService) LoadSlots(eventId uuid.UUID) {
 	}
 
 	// Get all active user IDs and their availabilities
-	userAvailabilities := make(map[uuid.UUID][]TimeSlot)
-	for _, availability := range availabilities {
-		userAvailabilities[availability.AccountId] = append(
-			userAvailabilities[availability.AccountId],
-			TimeSlot{
-				StartsAt: availability.StartsAt,
-				EndsAt:   availability.EndsAt,
-			},
-		)
-	}
+	userAvailabilities := groupMemberAvailabilities(&event, availabilities)
 
 	// If less than 2 active users, no slots can be created
 	if len(userAvailabilities) < 2 {
@@ -259,6 +442,70 @@ func (s *SlotService) LoadSlots(eventId uuid.UUID) {
 
 	// Send new slots to all participants via SSE
 	s.sseService.BroadcastSlotsUpdate(eventId, slots)
+
+	// Confirm the slot when everybody answered and only one placement of the duration remains, unless it is the slot a reschedule moved away from
+	if event.AutoConfirm && hasSinglePlacement(slots, time.Duration(event.Duration)*time.Minute) && everyContributorAnswered(&event, userAvailabilities) && !containsPreviousSlot(&event, slots[0]) {
+		result, err := s.confirmFromStart(slots[0].Id)
+		if err != nil {
+			log.Error().Err(err).Str("eventId", eventId.String()).Msg("Failed to auto-confirm the slot")
+			return
+		}
+		s.sseService.Broadcast(eventId, constants.SSE_EVENT_SLOT_AUTO_CONFIRMED, result)
+	}
+}
+
+// hasSinglePlacement checks if the slots leave exactly one way to place the duration on the grid: a single window
+// that the duration fills without room to move to the next grid step
+func hasSinglePlacement(slots []model.Slot, duration time.Duration) bool {
+	if len(slots) != 1 {
+		return false
+	}
+	slack := slots[0].EndsAt.Sub(slots[0].StartsAt) - duration
+	return slack >= 0 && slack < gridInterval
+}
+
+// containsPreviousSlot checks if the slot still allows the slot dropped by the last reschedule of the event
+func containsPreviousSlot(event *model.Event, slot model.Slot) bool {
+	previousSlot := event.GetPreviousSlot()
+	if event.RescheduledAt == nil || previousSlot == nil {
+		return false
+	}
+
+	return !previousSlot.StartsAt.Before(slot.StartsAt) && !previousSlot.EndsAt.After(slot.EndsAt)
+}
+
+// everyContributorAnswered checks that every member who can submit availabilities has at least one
+func everyContributorAnswered(event *model.Event, userAvailabilities map[uuid.UUID][]TimeSlot) bool {
+	for _, accountEvent := range event.AccountEvents {
+		if !event.Can(&accountEvent.AccountId, constants.EVENT_PERMISSION_CONTRIBUTE) {
+			continue
+		}
+		if len(userAvailabilities[accountEvent.AccountId]) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// groupMemberAvailabilities groups availabilities by account, ignoring accounts that cannot contribute to the event
+// (e.g. users whose join request is still pending, or viewers)
+func groupMemberAvailabilities(event *model.Event, availabilities []model.Availability) map[uuid.UUID][]TimeSlot {
+	userAvailabilities := make(map[uuid.UUID][]TimeSlot)
+	for _, availability := range availabilities {
+		if !event.Can(&availability.AccountId, constants.EVENT_PERMISSION_CONTRIBUTE) {
+			continue
+		}
+		userAvailabilities[availability.AccountId] = append(
+			userAvailabilities[availability.AccountId],
+			TimeSlot{
+				StartsAt: availability.StartsAt,
+				EndsAt:   availability.EndsAt,
+			},
+		)
+	}
+
+	return userAvailabilities
 }
 
 // Finds time slots where all users are available
@@ -1,12 +1,18 @@
 package slot
 
 import (
+	"app/commons/constants"
+	model "app/db/models"
+	"app/db/repository"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
 )
 
 func TestFindIntersectingTimeSlots_BasicIntersection(t *testing.T) {
@@ -218,6 +224,32 @@ func TestMergeOverlappingTimeSlots_AdjacentSlots(t *testing.T) {
 	assert.Equal(t, time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC), result[0].EndsAt, "Merged slot end should be 14:00")
 }
 
+func TestGroupMemberAvailabilities_IgnoresNonContributors(t *testing.T) {
+	memberId := uuid.New()
+	pendingId := uuid.New()
+	viewerId := uuid.New()
+	event := &model.Event{
+		AccountEvents: []model.AccountEvent{
+			{AccountId: memberId},
+			{AccountId: viewerId, Role: constants.EVENT_ROLE_VIEWER},
+		},
+	}
+	startsAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	availabilities := []model.Availability{
+		{AccountId: memberId, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)},
+		{AccountId: memberId, StartsAt: startsAt.Add(2 * time.Hour), EndsAt: startsAt.Add(3 * time.Hour)},
+		{AccountId: pendingId, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)},
+		{AccountId: viewerId, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)},
+	}
+
+	result := groupMemberAvailabilities(event, availabilities)
+
+	assert.Len(t, result, 1, "Only contributing members should be grouped")
+	assert.Len(t, result[memberId], 2)
+	assert.NotContains(t, result, pendingId)
+	assert.NotContains(t, result, viewerId)
+}
+
 func TestLoadSlots_ConcurrentCallsDoNotRace(t *testing.T) {
 	service := &SlotService{
 		loadSlotsMutexes: sync.Map{},
@@ -313,3 +345,156 @@ func TestLoadSlots_ConcurrentCallsDifferentEvents(t *testing.T) {
 		}
 	}
 }
+
+type fakeLinkGenerator struct {
+	calls int
+}
+
+func (g *fakeLinkGenerator) Generate(_ model.Event) (string, error) {
+	g.calls++
+	return "https://meet.example.com/room", nil
+}
+
+func TestGenerateMeetingLink_StoresLinkOnOptedInEvent(t *testing.T) {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, database.AutoMigrate(&model.Event{}))
+
+	event := model.Event{Id: uuid.New(), Name: "Remote sync", OwnerId: uuid.New(), Status: constants.EVENT_STATUS_UPCOMING, AutoMeetingLink: true}
+	require.NoError(t, database.Omit("Owner").Create(&event).Error)
+
+	generator := &fakeLinkGenerator{}
+	service := &SlotService{
+		eventRepository:      repository.NewEventRepository(database),
+		meetingLinkGenerator: generator,
+	}
+
+	service.generateMeetingLink(&event)
+
+	require.NotNil(t, event.MeetingUrl)
+	assert.Equal(t, "https://meet.example.com/room", *event.MeetingUrl)
+	var reloaded model.Event
+	require.NoError(t, database.Where("id = ?", event.Id).First(&reloaded).Error)
+	require.NotNil(t, reloaded.MeetingUrl)
+	assert.Equal(t, *event.MeetingUrl, *reloaded.MeetingUrl)
+}
+
+func TestGenerateMeetingLink_Skipped(t *testing.T) {
+	existing := "https://visio.example.com/team"
+
+	tests := []struct {
+		name      string
+		event     model.Event
+		generator *fakeLinkGenerator
+	}{
+		{
+			name:      "event did not opt in",
+			event:     model.Event{},
+			generator: &fakeLinkGenerator{},
+		},
+		{
+			name:      "event already has a meeting link",
+			event:     model.Event{AutoMeetingLink: true, MeetingUrl: &existing},
+			generator: &fakeLinkGenerator{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &SlotService{meetingLinkGenerator: tt.generator}
+			meetingUrl := tt.event.MeetingUrl
+
+			service.generateMeetingLink(&tt.event)
+
+			assert.Zero(t, tt.generator.calls)
+			assert.Equal(t, meetingUrl, tt.event.MeetingUrl)
+		})
+	}
+
+	t.Run("no generator configured", func(t *testing.T) {
+		event := model.Event{AutoMeetingLink: true}
+
+		(&SlotService{}).generateMeetingLink(&event)
+
+		assert.Nil(t, event.MeetingUrl)
+	})
+}
+
+func TestBestSlot(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 2, 0, 0, time.UTC)
+	later := model.Slot{Id: uuid.New(), StartsAt: now.Add(48 * time.Hour), EndsAt: now.Add(50 * time.Hour)}
+	sooner := model.Slot{Id: uuid.New(), StartsAt: now.Add(24 * time.Hour), EndsAt: now.Add(26 * time.Hour)}
+	validated := model.Slot{Id: uuid.New(), StartsAt: now.Add(2 * time.Hour), EndsAt: now.Add(3 * time.Hour), IsValidated: true}
+	tooShortLeft := model.Slot{Id: uuid.New(), StartsAt: now.Add(-time.Hour), EndsAt: now.Add(30 * time.Minute)}
+	started := model.Slot{Id: uuid.New(), StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(6 * time.Hour)}
+
+	best, startsAt := bestSlot([]model.Slot{later, validated, sooner, tooShortLeft}, time.Hour, now)
+	if assert.NotNil(t, best) {
+		assert.Equal(t, sooner.Id, best.Id)
+		assert.Equal(t, sooner.StartsAt, startsAt)
+	}
+
+	best, startsAt = bestSlot([]model.Slot{later, started}, time.Hour, now)
+	if assert.NotNil(t, best, "a slot that has started is kept while the duration still fits") {
+		assert.Equal(t, started.Id, best.Id)
+		assert.Equal(t, time.Date(2025, 3, 10, 12, 5, 0, 0, time.UTC), startsAt, "now is rounded up to the grid")
+	}
+
+	best, _ = bestSlot([]model.Slot{tooShortLeft, validated}, time.Hour, now)
+	assert.Nil(t, best)
+}
+
+func TestEveryContributorAnswered(t *testing.T) {
+	ownerId := uuid.New()
+	participantId := uuid.New()
+	viewerId := uuid.New()
+	event := &model.Event{
+		OwnerId: ownerId,
+		AccountEvents: []model.AccountEvent{
+			{AccountId: ownerId, Role: constants.EVENT_ROLE_OWNER},
+			{AccountId: participantId},
+			{AccountId: viewerId, Role: constants.EVENT_ROLE_VIEWER},
+		},
+	}
+	startsAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	answer := []TimeSlot{{StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}}
+
+	assert.True(t, everyContributorAnswered(event, map[uuid.UUID][]TimeSlot{ownerId: answer, participantId: answer}), "viewers are not expected to answer")
+	assert.False(t, everyContributorAnswered(event, map[uuid.UUID][]TimeSlot{ownerId: answer}))
+}
+
+func TestHasSinglePlacement(t *testing.T) {
+	startsAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	exact := model.Slot{StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}
+	offGrid := model.Slot{StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour + 3*time.Minute)}
+	oneStepLonger := model.Slot{StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour + gridInterval)}
+	slightlyLonger := model.Slot{StartsAt: startsAt, EndsAt: startsAt.Add(90 * time.Minute)}
+	tooShort := model.Slot{StartsAt: startsAt, EndsAt: startsAt.Add(45 * time.Minute)}
+	wide := model.Slot{StartsAt: startsAt, EndsAt: startsAt.Add(8 * time.Hour)}
+
+	assert.True(t, hasSinglePlacement([]model.Slot{exact}, time.Hour))
+	assert.True(t, hasSinglePlacement([]model.Slot{offGrid}, time.Hour), "the duration cannot move to the next grid step")
+	assert.False(t, hasSinglePlacement([]model.Slot{oneStepLonger}, time.Hour), "the duration can start at both grid steps")
+	assert.False(t, hasSinglePlacement([]model.Slot{slightlyLonger}, time.Hour), "a 90-minute window leaves seven 1-hour placements")
+	assert.False(t, hasSinglePlacement([]model.Slot{tooShort}, time.Hour))
+	assert.False(t, hasSinglePlacement([]model.Slot{wide}, time.Hour), "an 8-hour window leaves several 1-hour placements")
+	assert.False(t, hasSinglePlacement([]model.Slot{exact, wide}, time.Hour))
+	assert.False(t, hasSinglePlacement(nil, time.Hour))
+}
+
+func TestContainsPreviousSlot(t *testing.T) {
+	previousStartsAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	previousEndsAt := previousStartsAt.Add(time.Hour)
+	rescheduledAt := previousStartsAt.Add(-24 * time.Hour)
+	event := &model.Event{PreviousSlotStartsAt: &previousStartsAt, PreviousSlotEndsAt: &previousEndsAt, RescheduledAt: &rescheduledAt}
+
+	around := model.Slot{StartsAt: previousStartsAt.Add(-time.Hour), EndsAt: previousEndsAt.Add(time.Hour)}
+	overlapping := model.Slot{StartsAt: previousStartsAt.Add(30 * time.Minute), EndsAt: previousEndsAt.Add(time.Hour)}
+
+	assert.True(t, containsPreviousSlot(event, around))
+	assert.False(t, containsPreviousSlot(event, overlapping))
+
+	// Once the new slot is confirmed, the previous one no longer blocks the automatic confirmation
+	event.RescheduledAt = nil
+	assert.False(t, containsPreviousSlot(event, around))
+}
@@ -1,6 +1,7 @@
 package sse
 
 import (
+	"app/commons/constants"
 	model "app/db/models"
 	"app/db/repository"
 	"context"
@@ -19,7 +20,7 @@ type SSEClient struct {
 	Id      string
 	UserId  uuid.UUID
 	EventId uuid.UUID
-	Channel chan []byte
+	Channel chan SSEMessage
 	Context context.Context
 	Cancel  context.CancelFunc
 }
@@ -34,6 +35,12 @@ type SSEService struct {
 
 type SlotUpdateMessage []model.Slot
 
+// SSEMessage is a frame sent to a client. Unnamed frames carry slot updates.
+type SSEMessage struct {
+	Event constants.SSEEvent
+	Data  []byte
+}
+
 const (
 	defaultChannelBuffer = 10 // Buffer size for SSE client channels
 )
@@ -75,7 +82,7 @@ func (s *SSEService) AddClient(clientId string, userId uuid.UUID, eventId uuid.U
 		Id:      clientId,
 		UserId:  userId,
 		EventId: eventId,
-		Channel: make(chan []byte, defaultChannelBuffer),
+		Channel: make(chan SSEMessage, defaultChannelBuffer),
 		Context: clientCtx,
 		Cancel:  cancel,
 	}
@@ -119,24 +126,49 @@ func (s *SSEService) RemoveClient(clientId string) {
 
 // BroadcastSlotsUpdate sends slot updates to all participants of an event
 func (s *SSEService) BroadcastSlotsUpdate(eventId uuid.UUID, slots []model.Slot) {
-	s.mutex.RLock()
+	messageBytes, err := json.Marshal(SlotUpdateMessage(slots))
+	if err != nil {
+		log.Error().Err(err).Str("eventId", eventId.String()).Msg("Failed to marshal SSE message")
+		return
+	}
 
-	message := SlotUpdateMessage(slots)
+	s.send(eventId, nil, SSEMessage{Data: messageBytes})
+}
+
+// Broadcast sends a named event to all participants of an event
+func (s *SSEService) Broadcast(eventId uuid.UUID, event constants.SSEEvent, payload any) {
+	s.sendNamed(eventId, nil, event, payload)
+}
 
-	messageBytes, err := json.Marshal(message)
+// SendToUser sends a named event to the connections of a single participant of an event
+func (s *SSEService) SendToUser(eventId uuid.UUID, userId uuid.UUID, event constants.SSEEvent, payload any) {
+	s.sendNamed(eventId, &userId, event, payload)
+}
+
+func (s *SSEService) sendNamed(eventId uuid.UUID, userId *uuid.UUID, event constants.SSEEvent, payload any) {
+	messageBytes, err := json.Marshal(payload)
 	if err != nil {
-		log.Error().Err(err).Str("eventId", eventId.String()).Msg("Failed to marshal SSE message")
-		s.mutex.RUnlock()
+		log.Error().Err(err).Str("eventId", eventId.String()).Str("event", string(event)).Msg("Failed to marshal SSE message")
 		return
 	}
 
+	s.send(eventId, userId, SSEMessage{Event: event, Data: messageBytes})
+}
+
+// send delivers a message to the clients of an event, restricted to one user when userId is set
+func (s *SSEService) send(eventId uuid.UUID, userId *uuid.UUID, message SSEMessage) {
+	s.mutex.RLock()
+
 	var clientsToRemove []string
 	var sentCount int
 	if eventClients, exists := s.clientsByEvent[eventId]; exists {
 		for clientId := range eventClients {
 			if client, exists := s.clients[clientId]; exists {
+				if userId != nil && client.UserId != *userId {
+					continue
+				}
 				select {
-				case client.Channel <- messageBytes:
+				case client.Channel <- message:
 					sentCount++
 				case <-client.Context.Done():
 					// Collect clients to remove instead of removing immediately
@@ -157,6 +189,19 @@ func (s *SSEService) BroadcastSlotsUpdate(eventId uuid.UUID, slots []model.Slot)
 	}
 }
 
+// DisconnectUser closes the connections of a user to an event, e.g. when the user leaves it
+func (s *SSEService) DisconnectUser(eventId uuid.UUID, userId uuid.UUID) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for clientId := range s.clientsByEvent[eventId] {
+		if client, exists := s.clients[clientId]; exists && client.UserId == userId {
+			// The connection handler returns and removes the client
+			client.Cancel()
+		}
+	}
+}
+
 // GetConnectedClientsCount returns the number of connected clients for an event
 func (s *SSEService) GetConnectedClientsCount(eventId uuid.UUID) int {
 	s.mutex.RLock()
@@ -183,7 +228,7 @@ func (s *SSEService) HandleSSEConnection(c *gin.Context, userId uuid.UUID, event
 		c.JSON(http.StatusNotFound, gin.H{"error": "Event not found"})
 		return
 	}
-	if !event.HasUserAccess(&userId) {
+	if !event.Can(&userId, constants.EVENT_PERMISSION_VIEW) {
 		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied to event"})
 		return
 	}
@@ -217,7 +262,13 @@ func (s *SSEService) HandleSSEConnection(c *gin.Context, userId uuid.UUID, event
 	for {
 		select {
 		case message := <-client.Channel:
-			if _, err := fmt.Fprintf(c.Writer, "data: %s\n\n", string(message)); err != nil {
+			if message.Event != "" {
+				if _, err := fmt.Fprintf(c.Writer, "event: %s\n", message.Event); err != nil {
+					log.Error().Err(err).Str("clientId", clientId).Msg("Failed to send SSE message to client")
+					return
+				}
+			}
+			if _, err := fmt.Fprintf(c.Writer, "data: %s\n\n", string(message.Data)); err != nil {
 				log.Error().Err(err).Str("clientId", clientId).Msg("Failed to send SSE message to client")
 				return
 			}
@@ -3,11 +3,18 @@ package server
 import (
 	"app/commons/guard"
 	"app/pkg/account"
+	"app/pkg/activity"
 	"app/pkg/auth"
 	"app/pkg/availability"
+	"app/pkg/comment"
 	"app/pkg/event"
+	"app/pkg/eventtemplate"
 	"app/pkg/health"
+	"app/pkg/invitation"
+	"app/pkg/joinrequest"
+	"app/pkg/ownership"
 	"app/pkg/provider"
+	"app/pkg/rsvp"
 	"app/pkg/signin"
 	"app/pkg/slot"
 	"app/pkg/sse"
@@ -29,6 +36,8 @@ func NewRouter() *gin.Engine {
 
 	healthRouter := new(health.HealthController)
 
+	guestAllowed := guard.AuthCheck(&guard.AuthCheckParams{RequireAuthentication: true, RequireCompleteProfile: true, AllowGuest: true})
+
 	router.GET("/readyz", healthRouter.Ready)
 	router.GET("/healthz", healthRouter.Status)
 
@@ -40,7 +49,7 @@ func NewRouter() *gin.Engine {
 			accountRouter := account.NewAccountController(nil)
 
 			accountGroup.POST("", accountRouter.Create)
-			accountGroup.GET("/me", guard.AuthCheck(nil), accountRouter.GetMe)
+			accountGroup.GET("/me", guestAllowed, accountRouter.GetMe)
 			accountGroup.PATCH("", guard.AuthCheck(&guard.AuthCheckParams{RequireAuthentication: true, RequireCompleteProfile: false}), accountRouter.Update)
 			accountGroup.PATCH("/avatar", guard.AuthCheck(nil), guard.MaxUploadSizeMiddleware(10<<20), accountRouter.UploadAvatar)
 			accountGroup.GET("/:accountId/avatar", accountRouter.GetAvatar)
@@ -70,8 +79,8 @@ func NewRouter() *gin.Engine {
 		availabilityGroup := v1.Group("/availabilities")
 		availabilityRouter := availability.NewAvailabilityController(nil)
 		{
-			availabilityGroup.DELETE("/:availabilityId", guard.AuthCheck(nil), availabilityRouter.Delete)
-			availabilityGroup.PATCH("/:availabilityId", guard.AuthCheck(nil), availabilityRouter.Update)
+			availabilityGroup.DELETE("/:availabilityId", guestAllowed, availabilityRouter.Delete)
+			availabilityGroup.PATCH("/:availabilityId", guestAllowed, availabilityRouter.Update)
 		}
 
 		// Event routes
@@ -79,39 +88,124 @@ func NewRouter() *gin.Engine {
 		{
 			eventRouter := event.NewEventController(nil)
 
-			eventGroup.GET("", guard.AuthCheck(nil), eventRouter.GetUserEvents)
+			eventGroup.GET("", guestAllowed, eventRouter.GetUserEvents)
 			eventGroup.POST("", guard.AuthCheck(nil), eventRouter.Create)
 			eventGroup.PATCH("/:eventId", guard.AuthCheck(nil), eventRouter.Update)
 
 			specificEventGroup := eventGroup.Group("/:eventId")
 			{
-				specificEventGroup.GET("", guard.AuthCheck(nil), eventRouter.GetEvent)
+				specificEventGroup.GET("", guestAllowed, eventRouter.GetEvent)
 				specificEventGroup.GET("/summary", eventRouter.GetEventSummary)
-				specificEventGroup.POST("/join", guard.AuthCheck(nil), eventRouter.JoinEvent)
-				specificEventGroup.PATCH("/profile", guard.AuthCheck(nil), eventRouter.UpdateProfile)
+				specificEventGroup.POST("/join", guestAllowed, eventRouter.JoinEvent)
+				specificEventGroup.POST("/guest", guard.AuthCheck(&guard.AuthCheckParams{RequireAuthentication: false, RequireCompleteProfile: false, AllowGuest: true}), eventRouter.JoinAsGuest)
+				specificEventGroup.PATCH("/profile", guestAllowed, eventRouter.UpdateProfile)
+				specificEventGroup.PATCH("/participants/:participantId/role", guard.AuthCheck(nil), eventRouter.UpdateParticipantRole)
+				specificEventGroup.DELETE("/participants/:participantId", guard.AuthCheck(nil), eventRouter.RemoveParticipant)
+				specificEventGroup.POST("/leave", guestAllowed, eventRouter.Leave)
+				specificEventGroup.POST("/cancel", guard.AuthCheck(nil), eventRouter.Cancel)
+				specificEventGroup.POST("/clone", guard.AuthCheck(nil), eventRouter.Clone)
 			}
 
 			// Availability routes
 			{
-				eventGroup.POST("/:eventId/availability", guard.AuthCheck(nil), availabilityRouter.Create)
+				eventGroup.POST("/:eventId/availability", guestAllowed, availabilityRouter.Create)
+				eventGroup.POST("/:eventId/availability/copy", guestAllowed, availabilityRouter.CopyFromEvent)
+				eventGroup.GET("/:eventId/availability/export", guestAllowed, availabilityRouter.Export)
+			}
+
+			// Invitation routes
+			{
+				invitationRouter := invitation.NewInvitationController(nil)
+				eventGroup.POST("/:eventId/invitations", guard.AuthCheck(nil), invitationRouter.Create)
+				eventGroup.GET("/:eventId/invitations", guard.AuthCheck(nil), invitationRouter.List)
+				eventGroup.DELETE("/:eventId/invitations/:invitationId", guard.AuthCheck(nil), invitationRouter.Revoke)
+			}
+
+			// Join request routes
+			{
+				joinRequestRouter := joinrequest.NewJoinRequestController(nil)
+				eventGroup.GET("/:eventId/join-requests", guard.AuthCheck(nil), joinRequestRouter.List)
+				eventGroup.POST("/:eventId/join-requests/:requestId/approve", guard.AuthCheck(nil), joinRequestRouter.Approve)
+				eventGroup.POST("/:eventId/join-requests/:requestId/reject", guard.AuthCheck(nil), joinRequestRouter.Reject)
+				eventGroup.GET("/:eventId/waitlist", guard.AuthCheck(nil), joinRequestRouter.ListWaitlist)
+			}
+
+			// Ownership routes
+			{
+				ownershipRouter := ownership.NewOwnershipController(nil)
+				eventGroup.POST("/:eventId/ownership/nomination", guard.AuthCheck(nil), ownershipRouter.Nominate)
+				eventGroup.DELETE("/:eventId/ownership/nomination", guard.AuthCheck(nil), ownershipRouter.CancelNomination)
+				eventGroup.POST("/:eventId/ownership/accept", guard.AuthCheck(nil), ownershipRouter.Accept)
+			}
+
+			// RSVP routes
+			{
+				rsvpRouter := rsvp.NewRsvpController(nil)
+				eventGroup.PUT("/:eventId/rsvp", guestAllowed, rsvpRouter.Answer)
+				eventGroup.GET("/:eventId/rsvps", guard.AuthCheck(nil), rsvpRouter.List)
+			}
+
+			// Activity routes
+			{
+				activityRouter := activity.NewActivityController(nil)
+				eventGroup.GET("/:eventId/activity", guestAllowed, activityRouter.List)
+			}
+
+			// Comment routes
+			{
+				commentRouter := comment.NewCommentController(nil)
+				eventGroup.GET("/:eventId/comments", guestAllowed, commentRouter.List)
+				eventGroup.POST("/:eventId/comments", guestAllowed, commentRouter.Create)
+				eventGroup.PUT("/:eventId/comments/digest", guard.AuthCheck(nil), commentRouter.SetDigest)
+				eventGroup.PATCH("/:eventId/comments/:commentId", guestAllowed, commentRouter.Update)
+				eventGroup.DELETE("/:eventId/comments/:commentId", guestAllowed, commentRouter.Delete)
 			}
 
 			// SSE routes
 			{
 				sseRouter := sse.NewSSEController(nil)
-				eventGroup.GET("/:eventId/sse", guard.AuthCheck(nil), sseRouter.Connect)
+				eventGroup.GET("/:eventId/sse", guestAllowed, sseRouter.Connect)
 			}
 
 		}
 
+		// Event template routes
+		eventTemplateGroup := v1.Group("/event-templates")
+		{
+			eventTemplateRouter := eventtemplate.NewEventTemplateController(nil)
+
+			eventTemplateGroup.GET("", guard.AuthCheck(nil), eventTemplateRouter.List)
+			eventTemplateGroup.POST("", guard.AuthCheck(nil), eventTemplateRouter.Create)
+			eventTemplateGroup.DELETE("/:templateId", guard.AuthCheck(nil), eventTemplateRouter.Delete)
+			eventTemplateGroup.POST("/:templateId/events", guard.AuthCheck(nil), eventTemplateRouter.CreateEvent)
+		}
+
+		// RSVP routes, authenticated by the signed token of the confirmation email
+		rsvpGroup := v1.Group("/rsvp")
+		{
+			rsvpRouter := rsvp.NewRsvpController(nil)
+
+			rsvpGroup.GET("", rsvpRouter.GetByToken)
+			rsvpGroup.PUT("", rsvpRouter.AnswerByToken)
+		}
+
 		// Slot routes
 		slotGroup := v1.Group("/slots")
 		{
 			slotRouter := slot.NewSlotController(nil)
 
 			slotGroup.POST("/:slotId/confirm", guard.AuthCheck(nil), slotRouter.ConfirmSlot)
+			slotGroup.POST("/:slotId/reschedule", guard.AuthCheck(nil), slotRouter.Reschedule)
 			slotGroup.DELETE("/:slotId", guard.AuthCheck(nil), slotRouter.RemoveValidatedSlot)
 		}
+
+		// Admin routes
+		adminGroup := v1.Group("/admin", guard.AdminCheck())
+		{
+			ownershipRouter := ownership.NewOwnershipController(nil)
+
+			adminGroup.POST("/events/:eventId/ownership", ownershipRouter.AdminTransfer)
+		}
 	}
 
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -2,15 +2,62 @@ package server
 
 import (
 	"app/config"
+	"app/pkg/activity"
+	"app/pkg/deadline"
+	"app/pkg/digest"
+	"app/pkg/domainevent"
+	"app/pkg/lifecycle"
+	"context"
+	"errors"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
 )
 
+// shutdownTimeout is how long the requests in progress have to finish once the server is asked to stop
+const shutdownTimeout = 10 * time.Second
+
 func Init() {
 
 	c := config.GetConfig()
 
-	r := NewRouter()
-	err := r.Run(c.Host + ":" + c.Port)
-	if err != nil {
+	// Cancelled on SIGINT/SIGTERM, which stops the background jobs and the server
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Domain event handlers
+	activity.NewActivityService(nil).Subscribe(domainevent.GetBus())
+
+	// Background jobs
+	deadline.NewDeadlineService(nil).Start(ctx)
+	lifecycle.NewLifecycleService(nil).Start(ctx)
+	digest.NewDigestService(nil).Start(ctx)
+
+	srv := &http.Server{
+		Addr:    c.Host + ":" + c.Port,
+		Handler: NewRouter(),
+	}
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+		// A second signal kills the process right away
+		stop()
+		log.Info().Msg("Shutting down the server")
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("Failed to shut down the server gracefully")
+		}
+	}()
+
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		panic(err)
 	}
+	// ListenAndServe returns as soon as the shutdown starts, wait for the requests in progress
+	<-shutdownDone
 }